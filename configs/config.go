@@ -21,6 +21,8 @@ type App struct {
 	TokenExpiry time.Duration `json:"tokenExpiry"`
 	Async       Async         `json:"async"`
 	RateLimiter RateLimiter   `json:"rateLimiter"`
+	SLA         SLA           `json:"sla"`
+	Spreadsheet Spreadsheet   `json:"spreadsheet"`
 }
 
 type Async struct {
@@ -32,7 +34,9 @@ type Async struct {
 }
 
 type TaskTypes struct {
-	SendEmail string `json:"sendEmail"`
+	SendEmail      string `json:"sendEmail"`
+	PublishRequest string `json:"publishRequest"`
+	ProcessImport  string `json:"processImport"`
 }
 
 type RateLimiter struct {
@@ -40,6 +44,20 @@ type RateLimiter struct {
 	Burst int        `json:"burst"`
 }
 
+type SLA struct {
+	WorkingDays           map[string]int `json:"workingDays"`
+	Holidays              []string       `json:"holidays"`
+	EscalationIntervalSec int            `json:"escalationIntervalSec"`
+}
+
+type Spreadsheet struct {
+	MaxFileSizeMB           int64  `json:"maxFileSizeMB"`
+	MaxRows                 int    `json:"maxRows"`
+	ImportRetentionHours    int    `json:"importRetentionHours"`
+	ImportExpiryIntervalSec int    `json:"importExpiryIntervalSec"`
+	ImportDir               string `json:"importDir"`
+}
+
 type Secret struct {
 	JWT string `json:"jwt"`
 }
@@ -61,6 +79,7 @@ type Redis struct {
 
 type External struct {
 	MsGraph MsGraph `json:"msGraph"`
+	SAP     SAP     `json:"sap"`
 }
 
 type MsGraph struct {
@@ -77,6 +96,24 @@ type MsGraph struct {
 	EncodedThumbprint  string          `json:"-"`
 }
 
+type SAP struct {
+	Client          string      `json:"client"`
+	SenderPort      string      `json:"senderPort"`
+	SenderPartner   string      `json:"senderPartner"`
+	ReceiverPort    string      `json:"receiverPort"`
+	ReceiverPartner string      `json:"receiverPartner"`
+	Publisher       string      `json:"publisher"`
+	ProductAPIURL   string      `json:"productAPIURL"`
+	Username        string      `json:"username"`
+	Password        string      `json:"password"`
+	FakeNumberRange NumberRange `json:"fakeNumberRange"`
+}
+
+type NumberRange struct {
+	From int64 `json:"from"`
+	To   int64 `json:"to"`
+}
+
 type Config struct {
 	App      App      `json:"app"`
 	Secret   Secret   `json:"secret"`
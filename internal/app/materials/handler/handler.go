@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log/slog"
 	"mime/multipart"
 	"net/http"
@@ -16,13 +17,35 @@ import (
 	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
 )
 
+//go:generate mockgen -source=./handler.go -destination=./mock.go -package=handler
+
 type Service interface {
 	CreateMaterialType(ctx context.Context, mt model.MaterialType) *errors.Error
 	CreateMaterialUoM(ctx context.Context, uom model.MaterialUoM) *errors.Error
 	CreateMaterialGroup(ctx context.Context, mg model.MaterialGroup) *errors.Error
 	CreatePlant(ctx context.Context, p model.Plant) *errors.Error
 	CreateManufacturer(ctx context.Context, m model.Manufacturer) *errors.Error
-	BulkCreateManufacturers(ctx context.Context, file multipart.File) *errors.Error
+	BulkCreateMaterialTypes(ctx context.Context, file multipart.File, filename string, mode model.BulkImportMode) (*model.BulkImportReport, *errors.Error)
+	BulkCreateMaterialUoMs(ctx context.Context, file multipart.File, filename string, mode model.BulkImportMode) (*model.BulkImportReport, *errors.Error)
+	BulkCreateMaterialGroups(ctx context.Context, file multipart.File, filename string, mode model.BulkImportMode) (*model.BulkImportReport, *errors.Error)
+	BulkCreatePlants(ctx context.Context, file multipart.File, filename string, mode model.BulkImportMode) (*model.BulkImportReport, *errors.Error)
+	BulkCreateManufacturers(ctx context.Context, file multipart.File, filename string, mode model.BulkImportMode) (*model.BulkImportReport, *errors.Error)
+	ExportBulkImportReport(report *model.BulkImportReport) ([]byte, *errors.Error)
+	CreatePlantMaterialType(ctx context.Context, pmt model.PlantMaterialType) *errors.Error
+	ListPlantMaterialTypes(ctx context.Context, plantCode string) ([]*model.MaterialType, *errors.Error)
+	DeletePlantMaterialType(ctx context.Context, pmt model.PlantMaterialType) *errors.Error
+	CreateMaterialTypeGroup(ctx context.Context, mtg model.MaterialTypeGroup) *errors.Error
+	ListMaterialTypeGroups(ctx context.Context, typeCode string) ([]*model.MaterialGroup, *errors.Error)
+	DeleteMaterialTypeGroup(ctx context.Context, mtg model.MaterialTypeGroup) *errors.Error
+	CreateImportJob(ctx context.Context, file io.Reader, filename string, r model.CreateImportJobRequest, requestedBy *model.Auth) (*model.ImportJob, *errors.Error)
+	GetImportJob(ctx context.Context, ID model.UUID) (*model.ImportJob, *errors.Error)
+	CommitImportJob(ctx context.Context, ID model.UUID) (*model.ImportJob, *errors.Error)
+	ExportMaterialTypes(ctx context.Context) ([]byte, *errors.Error)
+	ExportMaterialUoMs(ctx context.Context) ([]byte, *errors.Error)
+	ExportMaterialGroups(ctx context.Context) ([]byte, *errors.Error)
+	ExportPlants(ctx context.Context) ([]byte, *errors.Error)
+	ExportManufacturers(ctx context.Context) ([]byte, *errors.Error)
+	ExportRequestTemplate(ctx context.Context) ([]byte, *errors.Error)
 	ListMaterialTypes(ctx context.Context, criteria model.ListMaterialTypesCriteria) (*model.MaterialTypes, *errors.Error)
 	ListMaterialUoMs(ctx context.Context, criteria model.ListMaterialUoMsCriteria) (*model.MaterialUoMs, *errors.Error)
 	ListMaterialGroups(ctx context.Context, criteria model.ListMaterialGroupsCriteria) (*model.MaterialGroups, *errors.Error)
@@ -43,6 +66,15 @@ type Service interface {
 	DeleteMaterialGroup(ctx context.Context, code string) *errors.Error
 	DeletePlant(ctx context.Context, code string) *errors.Error
 	DeleteManufacturer(ctx context.Context, code string) *errors.Error
+	ListMaterials(ctx context.Context, criteria model.ListMaterialsCriteria) (*model.Materials, *errors.Error)
+	GetMaterial(ctx context.Context, ID model.UUID) (*model.Material, *errors.Error)
+	ListMaterialsByNumber(ctx context.Context, number string) ([]*model.Material, *errors.Error)
+	CreateAbbreviation(ctx context.Context, a model.Abbreviation) *errors.Error
+	ListAbbreviations(ctx context.Context, criteria model.ListAbbreviationsCriteria) (*model.Abbreviations, *errors.Error)
+	GetAbbreviation(ctx context.Context, term string) (*model.Abbreviation, *errors.Error)
+	UpdateAbbreviation(ctx context.Context, a model.Abbreviation) *errors.Error
+	DeleteAbbreviation(ctx context.Context, term string) *errors.Error
+	PreviewShortText(ctx context.Context, longText string, shortText *string) (*model.ShortTextPreview, *errors.Error)
 }
 
 type Handler struct {
@@ -323,10 +355,41 @@ func (h *Handler) CreateManufacturer(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusCreated)
 }
 
+func (h *Handler) BulkCreateMaterialType(w http.ResponseWriter, r *http.Request) {
+	h.bulkCreate(w, r, h.service.BulkCreateMaterialTypes, true)
+}
+
+func (h *Handler) BulkCreateMaterialUoM(w http.ResponseWriter, r *http.Request) {
+	h.bulkCreate(w, r, h.service.BulkCreateMaterialUoMs, true)
+}
+
+func (h *Handler) BulkCreateMaterialGroup(w http.ResponseWriter, r *http.Request) {
+	h.bulkCreate(w, r, h.service.BulkCreateMaterialGroups, true)
+}
+
+func (h *Handler) BulkCreatePlant(w http.ResponseWriter, r *http.Request) {
+	h.bulkCreate(w, r, h.service.BulkCreatePlants, true)
+}
+
 func (h *Handler) BulkCreateManufacturer(w http.ResponseWriter, r *http.Request) {
+	h.bulkCreate(w, r, h.service.BulkCreateManufacturers, false)
+}
+
+func (h *Handler) bulkCreate(w http.ResponseWriter, r *http.Request, create func(ctx context.Context, file multipart.File, filename string, mode model.BulkImportMode) (*model.BulkImportReport, *errors.Error), adminOnly bool) {
 	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
 
-	file, _, err := r.FormFile("file")
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if adminOnly && !auth.IsAdmin() {
+		slog.ErrorContext(r.Context(), errors.ResourceIsForbidden.String(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.ResourceIsForbidden.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	file, header, err := r.FormFile("file")
 	if err != nil {
 		slog.ErrorContext(r.Context(), errors.New(errors.ParsingFileFailure).Wrap(err).Error(), slog.String("requestID", requestID))
 		w.WriteHeader(http.StatusBadRequest)
@@ -338,11 +401,34 @@ func (h *Handler) BulkCreateManufacturer(w http.ResponseWriter, r *http.Request)
 	}
 	defer file.Close()
 
-	errCreate := h.service.BulkCreateManufacturers(r.Context(), file)
+	mode, err := model.ParseBulkImportMode(r.FormValue("mode"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONValidationFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONValidationFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	if mode == model.BulkUpsert && !auth.IsAdmin() {
+		slog.ErrorContext(r.Context(), errors.ResourceIsForbidden.String(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.ResourceIsForbidden.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	report, errCreate := create(r.Context(), file, header.Filename, mode)
 	if errCreate != nil {
 		slog.ErrorContext(r.Context(), errCreate.Error(), slog.String("requestID", requestID))
 		switch {
-		case errCreate.ContainsCodes(errors.ManufacturerAlreadyExists):
+		case errCreate.ContainsCodes(errors.ParsingFileFailure, errors.FileOversize, errors.TooManyRows, errors.EmptySpreadsheet, errors.UnknownField):
+			w.WriteHeader(http.StatusBadRequest)
+		case errCreate.ContainsCodes(errors.DuplicateSpreadsheetColumn):
 			w.WriteHeader(http.StatusConflict)
 		default:
 			w.WriteHeader(http.StatusInternalServerError)
@@ -354,7 +440,235 @@ func (h *Handler) BulkCreateManufacturer(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if strings.Contains(r.Header.Get("Accept"), "spreadsheetml") {
+		data, errExport := h.service.ExportBulkImportReport(report)
+		h.writeSpreadsheet(w, r, "import_report.xlsx", data, errExport)
+		return
+	}
+
 	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": report,
+	})
+}
+
+func (h *Handler) CreateImportJob(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if !auth.IsAdmin() {
+		slog.ErrorContext(r.Context(), errors.ResourceIsForbidden.String(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.ResourceIsForbidden.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.ParsingFileFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.ParsingFileFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+	defer file.Close()
+
+	req := model.CreateImportJobRequest{
+		Entity: model.ImportEntity(r.FormValue("entity")),
+		Mode:   model.BulkImportMode(r.FormValue("mode")),
+	}
+	if dryRun := r.FormValue("dryRun"); len(dryRun) > 0 {
+		req.DryRun, err = strconv.ParseBool(dryRun)
+	}
+	if err == nil {
+		err = req.Validate()
+	}
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONValidationFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONValidationFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	job, errCreate := h.service.CreateImportJob(r.Context(), file, header.Filename, req, auth)
+	if errCreate != nil {
+		slog.ErrorContext(r.Context(), errCreate.Error(), slog.String("requestID", requestID))
+		switch {
+		case errCreate.ContainsCodes(errors.ParsingFileFailure, errors.FileOversize, errors.EmptySpreadsheet):
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errCreate.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": job,
+	})
+}
+
+func (h *Handler) GetImportJob(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if !auth.IsAdmin() {
+		slog.ErrorContext(r.Context(), errors.ResourceIsForbidden.String(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.ResourceIsForbidden.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	ID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedImportJobID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedImportJobID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	job, errGet := h.service.GetImportJob(r.Context(), ID)
+	if errGet != nil {
+		slog.ErrorContext(r.Context(), errGet.Error(), slog.String("requestID", requestID))
+		switch {
+		case errGet.ContainsCodes(errors.ImportJobNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errGet.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	if job.Report != nil && strings.Contains(r.Header.Get("Accept"), "spreadsheetml") {
+		data, errExport := h.service.ExportBulkImportReport(job.Report)
+		h.writeSpreadsheet(w, r, "import_report.xlsx", data, errExport)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": job,
+	})
+}
+
+func (h *Handler) CommitImportJob(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if !auth.IsAdmin() {
+		slog.ErrorContext(r.Context(), errors.ResourceIsForbidden.String(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.ResourceIsForbidden.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	ID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedImportJobID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedImportJobID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	job, errCommit := h.service.CommitImportJob(r.Context(), ID)
+	if errCommit != nil {
+		slog.ErrorContext(r.Context(), errCommit.Error(), slog.String("requestID", requestID))
+		switch {
+		case errCommit.ContainsCodes(errors.ImportJobNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errCommit.ContainsCodes(errors.ImportJobIsNotPreviewed):
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errCommit.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": job,
+	})
+}
+
+func (h *Handler) ExportMaterialTypes(w http.ResponseWriter, r *http.Request) {
+	data, err := h.service.ExportMaterialTypes(r.Context())
+	h.writeSpreadsheet(w, r, "material_types.xlsx", data, err)
+}
+
+func (h *Handler) ExportMaterialUoMs(w http.ResponseWriter, r *http.Request) {
+	data, err := h.service.ExportMaterialUoMs(r.Context())
+	h.writeSpreadsheet(w, r, "material_uoms.xlsx", data, err)
+}
+
+func (h *Handler) ExportMaterialGroups(w http.ResponseWriter, r *http.Request) {
+	data, err := h.service.ExportMaterialGroups(r.Context())
+	h.writeSpreadsheet(w, r, "material_groups.xlsx", data, err)
+}
+
+func (h *Handler) ExportPlants(w http.ResponseWriter, r *http.Request) {
+	data, err := h.service.ExportPlants(r.Context())
+	h.writeSpreadsheet(w, r, "plants.xlsx", data, err)
+}
+
+func (h *Handler) ExportManufacturers(w http.ResponseWriter, r *http.Request) {
+	data, err := h.service.ExportManufacturers(r.Context())
+	h.writeSpreadsheet(w, r, "manufacturers.xlsx", data, err)
+}
+
+func (h *Handler) ExportRequestTemplate(w http.ResponseWriter, r *http.Request) {
+	data, err := h.service.ExportRequestTemplate(r.Context())
+	h.writeSpreadsheet(w, r, "request_template.xlsx", data, err)
+}
+
+func (h *Handler) writeSpreadsheet(w http.ResponseWriter, r *http.Request, filename string, data []byte, err *errors.Error) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	if err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
 }
 
 func (h *Handler) ListMaterialTypes(w http.ResponseWriter, r *http.Request) {
@@ -1168,3 +1482,582 @@ func (h *Handler) DeleteManufacturer(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (h *Handler) CreatePlantMaterialType(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if !auth.IsAdmin() {
+		slog.ErrorContext(r.Context(), errors.ResourceIsForbidden.String(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.ResourceIsForbidden.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	req := new(model.CreatePlantMaterialTypeRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONDecodeFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONDecodeFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+	defer r.Body.Close()
+
+	if err := req.Validate(); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONValidationFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONValidationFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	if err := h.service.CreatePlantMaterialType(r.Context(), req.Model(r.PathValue("code"))); err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		switch {
+		case err.ContainsCodes(errors.MaterialPropertiesNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case err.ContainsCodes(errors.PlantMaterialTypeAlreadyExists):
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *Handler) ListPlantMaterialTypes(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	mts, err := h.service.ListPlantMaterialTypes(r.Context(), r.PathValue("code"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": mts,
+	})
+}
+
+func (h *Handler) DeletePlantMaterialType(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if !auth.IsAdmin() {
+		slog.ErrorContext(r.Context(), errors.ResourceIsForbidden.String(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.ResourceIsForbidden.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	if err := h.service.DeletePlantMaterialType(r.Context(), model.PlantMaterialType{PlantCode: r.PathValue("code"), TypeCode: r.PathValue("typeCode")}); err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) CreateMaterialTypeGroup(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if !auth.IsAdmin() {
+		slog.ErrorContext(r.Context(), errors.ResourceIsForbidden.String(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.ResourceIsForbidden.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	req := new(model.CreateMaterialTypeGroupRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONDecodeFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONDecodeFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+	defer r.Body.Close()
+
+	if err := req.Validate(); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONValidationFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONValidationFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	if err := h.service.CreateMaterialTypeGroup(r.Context(), req.Model(r.PathValue("code"))); err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		switch {
+		case err.ContainsCodes(errors.MaterialPropertiesNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case err.ContainsCodes(errors.MaterialTypeGroupAlreadyExists):
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *Handler) ListMaterialTypeGroups(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	mgs, err := h.service.ListMaterialTypeGroups(r.Context(), r.PathValue("code"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": mgs,
+	})
+}
+
+func (h *Handler) DeleteMaterialTypeGroup(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if !auth.IsAdmin() {
+		slog.ErrorContext(r.Context(), errors.ResourceIsForbidden.String(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.ResourceIsForbidden.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	if err := h.service.DeleteMaterialTypeGroup(r.Context(), model.MaterialTypeGroup{TypeCode: r.PathValue("code"), GroupCode: r.PathValue("groupCode")}); err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) ListMaterials(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	criteria, errMessages := h.buildListMaterialsCriteria(r.URL.Query())
+	if len(errMessages) != 0 {
+		slog.ErrorContext(r.Context(), errMessages, slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.InvalidQueryParameter.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	m, err := h.service.ListMaterials(r.Context(), criteria)
+	if err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		switch {
+		case err.ContainsCodes(errors.InvalidQueryParameter, errors.InvalidPageNumber, errors.InvalidItemNumberPerPage):
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(m.Response(criteria.Page))
+}
+
+func (h *Handler) buildListMaterialsCriteria(q url.Values) (model.ListMaterialsCriteria, string) {
+	c := model.ListMaterialsCriteria{}
+	messages := make([]string, 0, 5)
+
+	c.FilterMaterial.Number = q.Get("number")
+	c.FilterMaterial.PlantCode = q.Get("plant")
+	c.FilterMaterial.TypeCode = q.Get("type")
+	c.FilterMaterial.GroupCode = q.Get("group")
+	c.FilterMaterial.ManufacturerCode = q.Get("manufacturer")
+	c.FilterMaterial.Query = q.Get("q")
+
+	if statusStr := q.Get("status"); len(statusStr) != 0 {
+		status, err := strconv.Atoi(statusStr)
+		switch {
+		case err != nil:
+			messages = append(messages, fmt.Sprintf("status: %s", err.Error()))
+		case !model.Status(status).IsValid():
+			messages = append(messages, fmt.Sprintf("status: unknown value %d", status))
+		default:
+			c.FilterMaterial.Status = model.Status(status)
+		}
+	}
+
+	h.sort(q, &c.Sort, &messages, model.IsAvailableToSortMaterial)
+	h.paginate(q, &c.Page, &messages)
+
+	return c, strings.Join(messages, ", ")
+}
+
+func (h *Handler) GetMaterial(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	ID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedMaterialID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedMaterialID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	m, errGet := h.service.GetMaterial(r.Context(), ID)
+	if errGet != nil {
+		slog.ErrorContext(r.Context(), errGet.Error(), slog.String("requestID", requestID))
+		switch {
+		case errGet.ContainsCodes(errors.MaterialNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errGet.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": m,
+	})
+}
+
+func (h *Handler) ListMaterialsByNumber(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	m, err := h.service.ListMaterialsByNumber(r.Context(), r.PathValue("number"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		switch {
+		case err.ContainsCodes(errors.MaterialNumberNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": m,
+	})
+}
+
+func (h *Handler) CreateAbbreviation(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if !auth.IsAdmin() {
+		slog.ErrorContext(r.Context(), errors.ResourceIsForbidden.String(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.ResourceIsForbidden.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	req := new(model.UpsertAbbreviationRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONDecodeFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONDecodeFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+	defer r.Body.Close()
+
+	if err := req.Validate(); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONValidationFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONValidationFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	if err := h.service.CreateAbbreviation(r.Context(), req.Model()); err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		switch {
+		case err.ContainsCodes(errors.AbbreviationAlreadyExists):
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *Handler) ListAbbreviations(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	criteria, errMessages := h.buildListAbbreviationsCriteria(r.URL.Query())
+	if len(errMessages) != 0 {
+		slog.ErrorContext(r.Context(), errMessages, slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.InvalidQueryParameter.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	a, err := h.service.ListAbbreviations(r.Context(), criteria)
+	if err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		switch {
+		case err.ContainsCodes(errors.InvalidQueryParameter, errors.InvalidPageNumber, errors.InvalidItemNumberPerPage):
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(a.Response(criteria.Page))
+}
+
+func (h *Handler) buildListAbbreviationsCriteria(q url.Values) (model.ListAbbreviationsCriteria, string) {
+	c := model.ListAbbreviationsCriteria{}
+	messages := make([]string, 0, 5)
+
+	c.FilterAbbreviation.Term = q.Get("term")
+
+	h.sort(q, &c.Sort, &messages, model.IsAvailableToSortAbbreviation)
+	h.paginate(q, &c.Page, &messages)
+
+	return c, strings.Join(messages, ", ")
+}
+
+func (h *Handler) GetAbbreviation(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	a, err := h.service.GetAbbreviation(r.Context(), r.PathValue("term"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		switch {
+		case err.ContainsCodes(errors.AbbreviationNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": a,
+	})
+}
+
+func (h *Handler) UpdateAbbreviation(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if !auth.IsAdmin() {
+		slog.ErrorContext(r.Context(), errors.ResourceIsForbidden.String(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.ResourceIsForbidden.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	req := new(model.UpsertAbbreviationRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONDecodeFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONDecodeFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+	defer r.Body.Close()
+	req.Term = r.PathValue("term")
+
+	if err := req.Validate(); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONValidationFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONValidationFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	if err := h.service.UpdateAbbreviation(r.Context(), req.Model()); err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		switch {
+		case err.ContainsCodes(errors.AbbreviationNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) DeleteAbbreviation(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if !auth.IsAdmin() {
+		slog.ErrorContext(r.Context(), errors.ResourceIsForbidden.String(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.ResourceIsForbidden.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	if err := h.service.DeleteAbbreviation(r.Context(), r.PathValue("term")); err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) PreviewShortText(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	req := new(model.PreviewShortTextRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONDecodeFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONDecodeFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+	defer r.Body.Close()
+
+	if err := req.Validate(); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONValidationFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONValidationFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	preview, err := h.service.PreviewShortText(r.Context(), req.LongText, req.ShortText)
+	if err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": preview,
+	})
+}
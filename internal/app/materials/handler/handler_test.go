@@ -0,0 +1,384 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dev-pt-bai/cataloging/internal/app/middleware"
+	"github.com/dev-pt-bai/cataloging/internal/model"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+	"github.com/golang/mock/gomock"
+)
+
+func TestListMaterials(t *testing.T) {
+	service := NewMockService(gomock.NewController(t))
+	handler := New(service)
+
+	requestID := "dummy-request-id"
+	ctx := context.WithValue(context.Background(), middleware.RequestIDKey, requestID)
+
+	type response struct {
+		ErrorCode string `json:"errorCode"`
+		RequestID string `json:"requestID"`
+	}
+
+	type result struct {
+		code     int
+		response *response
+	}
+
+	tests := []struct {
+		name     string
+		query    url.Values
+		callFunc func()
+		want     result
+	}{
+		{
+			name:  "non-numeric status",
+			query: url.Values{"status": []string{"published"}},
+			want: result{
+				code:     http.StatusBadRequest,
+				response: &response{ErrorCode: errors.InvalidQueryParameter.String(), RequestID: requestID},
+			},
+		},
+		{
+			name:  "status below range",
+			query: url.Values{"status": []string{"0"}},
+			want: result{
+				code:     http.StatusBadRequest,
+				response: &response{ErrorCode: errors.InvalidQueryParameter.String(), RequestID: requestID},
+			},
+		},
+		{
+			name:  "status above range",
+			query: url.Values{"status": []string{"99"}},
+			want: result{
+				code:     http.StatusBadRequest,
+				response: &response{ErrorCode: errors.InvalidQueryParameter.String(), RequestID: requestID},
+			},
+		},
+		{
+			name:  "valid status",
+			query: url.Values{"status": []string{"5"}},
+			callFunc: func() {
+				service.EXPECT().ListMaterials(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, criteria model.ListMaterialsCriteria) (*model.Materials, *errors.Error) {
+					if criteria.FilterMaterial.Status != model.Published {
+						t.Errorf("want status: %v, got: %v", model.Published, criteria.FilterMaterial.Status)
+					}
+					return &model.Materials{}, nil
+				})
+			},
+			want: result{
+				code: http.StatusOK,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.callFunc != nil {
+				test.callFunc()
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/materials?"+test.query.Encode(), nil)
+			handler.ListMaterials(w, r)
+
+			result := w.Result()
+			defer result.Body.Close()
+
+			response := new(response)
+			json.NewDecoder(result.Body).Decode(response)
+
+			if test.want.code != result.StatusCode {
+				t.Errorf("want: %v, got: %v", test.want.code, result.StatusCode)
+			}
+
+			if test.want.response != nil && !reflect.DeepEqual(test.want.response, response) {
+				t.Errorf("want: %v, got: %v", test.want.response, response)
+			}
+		})
+	}
+}
+
+func newBulkRequest(ctx context.Context, target string, mode model.BulkImportMode) *http.Request {
+	body := new(bytes.Buffer)
+	mw := multipart.NewWriter(body)
+	fw, _ := mw.CreateFormFile("file", "import.csv")
+	fw.Write([]byte("Code,Description\nSKF,SKF Group\n"))
+	mw.WriteField("mode", string(mode))
+	mw.Close()
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, target, body)
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+
+	return r
+}
+
+func TestBulkCreateAccess(t *testing.T) {
+	service := NewMockService(gomock.NewController(t))
+	handler := New(service)
+
+	requestID := "dummy-request-id"
+	auth := &model.Auth{UserID: "dummy-requester", Role: model.Requester}
+	ctx := context.WithValue(context.WithValue(context.Background(), middleware.RequestIDKey, requestID), middleware.AuthKey, auth)
+
+	w := httptest.NewRecorder()
+	handler.BulkCreateMaterialType(w, newBulkRequest(ctx, "/bulk/material_types", model.BulkInsert))
+	if w.Code != http.StatusForbidden {
+		t.Errorf("want: %v, got: %v", http.StatusForbidden, w.Code)
+	}
+
+	for _, mode := range []model.BulkImportMode{model.BulkInsert, model.BulkSkipExisting} {
+		service.EXPECT().BulkCreateManufacturers(gomock.Any(), gomock.Any(), "import.csv", mode).Return(model.NewBulkImportReport(mode), nil)
+
+		w = httptest.NewRecorder()
+		handler.BulkCreateManufacturer(w, newBulkRequest(ctx, "/bulk/manufacturers", mode))
+		if w.Code != http.StatusCreated {
+			t.Errorf("want: %v, got: %v", http.StatusCreated, w.Code)
+		}
+	}
+
+	w = httptest.NewRecorder()
+	handler.BulkCreateManufacturer(w, newBulkRequest(ctx, "/bulk/manufacturers", model.BulkUpsert))
+	if w.Code != http.StatusForbidden {
+		t.Errorf("want: %v, got: %v", http.StatusForbidden, w.Code)
+	}
+
+	admin := &model.Auth{UserID: "dummy-admin", Role: model.Administrator}
+	service.EXPECT().BulkCreateManufacturers(gomock.Any(), gomock.Any(), "import.csv", model.BulkUpsert).Return(model.NewBulkImportReport(model.BulkUpsert), nil)
+
+	w = httptest.NewRecorder()
+	handler.BulkCreateManufacturer(w, newBulkRequest(context.WithValue(ctx, middleware.AuthKey, admin), "/bulk/manufacturers", model.BulkUpsert))
+	if w.Code != http.StatusCreated {
+		t.Errorf("want: %v, got: %v", http.StatusCreated, w.Code)
+	}
+}
+
+func TestPlantMaterialTypes(t *testing.T) {
+	service := NewMockService(gomock.NewController(t))
+	handler := New(service)
+
+	requestID := "dummy-request-id"
+	admin := &model.Auth{UserID: "dummy-admin", Role: model.Administrator}
+	requester := &model.Auth{UserID: "dummy-requester", Role: model.Requester}
+	newContext := func(auth *model.Auth) context.Context {
+		return context.WithValue(context.WithValue(context.Background(), middleware.RequestIDKey, requestID), middleware.AuthKey, auth)
+	}
+
+	tests := []struct {
+		name     string
+		auth     *model.Auth
+		method   string
+		body     string
+		callFunc func()
+		want     int
+	}{
+		{
+			name:   "create by non-administrator",
+			auth:   requester,
+			method: http.MethodPost,
+			body:   `{"typeCode":"ERSA"}`,
+			want:   http.StatusForbidden,
+		},
+		{
+			name:   "create without type code",
+			auth:   admin,
+			method: http.MethodPost,
+			body:   `{}`,
+			want:   http.StatusBadRequest,
+		},
+		{
+			name:   "create",
+			auth:   admin,
+			method: http.MethodPost,
+			body:   `{"typeCode":"ERSA"}`,
+			callFunc: func() {
+				service.EXPECT().CreatePlantMaterialType(gomock.Any(), model.PlantMaterialType{PlantCode: "1000", TypeCode: "ERSA"}).Return(nil)
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name:   "create existing link",
+			auth:   admin,
+			method: http.MethodPost,
+			body:   `{"typeCode":"ERSA"}`,
+			callFunc: func() {
+				service.EXPECT().CreatePlantMaterialType(gomock.Any(), gomock.Any()).Return(errors.New(errors.PlantMaterialTypeAlreadyExists))
+			},
+			want: http.StatusConflict,
+		},
+		{
+			name:   "create with unknown material type",
+			auth:   admin,
+			method: http.MethodPost,
+			body:   `{"typeCode":"XXXX"}`,
+			callFunc: func() {
+				service.EXPECT().CreatePlantMaterialType(gomock.Any(), gomock.Any()).Return(errors.New(errors.MaterialPropertiesNotFound))
+			},
+			want: http.StatusNotFound,
+		},
+		{
+			name:   "list",
+			auth:   requester,
+			method: http.MethodGet,
+			callFunc: func() {
+				service.EXPECT().ListPlantMaterialTypes(gomock.Any(), "1000").Return([]*model.MaterialType{{Code: "ERSA"}}, nil)
+			},
+			want: http.StatusOK,
+		},
+		{
+			name:   "delete by non-administrator",
+			auth:   requester,
+			method: http.MethodDelete,
+			want:   http.StatusForbidden,
+		},
+		{
+			name:   "delete",
+			auth:   admin,
+			method: http.MethodDelete,
+			callFunc: func() {
+				service.EXPECT().DeletePlantMaterialType(gomock.Any(), model.PlantMaterialType{PlantCode: "1000", TypeCode: "ERSA"}).Return(nil)
+			},
+			want: http.StatusNoContent,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.callFunc != nil {
+				test.callFunc()
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(newContext(test.auth), test.method, "/plants/1000/material_types", strings.NewReader(test.body))
+			r.SetPathValue("code", "1000")
+			switch test.method {
+			case http.MethodPost:
+				handler.CreatePlantMaterialType(w, r)
+			case http.MethodGet:
+				handler.ListPlantMaterialTypes(w, r)
+			case http.MethodDelete:
+				r.SetPathValue("typeCode", "ERSA")
+				handler.DeletePlantMaterialType(w, r)
+			}
+
+			if test.want != w.Code {
+				t.Errorf("want: %v, got: %v", test.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestMaterialTypeGroups(t *testing.T) {
+	service := NewMockService(gomock.NewController(t))
+	handler := New(service)
+
+	requestID := "dummy-request-id"
+	admin := &model.Auth{UserID: "dummy-admin", Role: model.Administrator}
+	requester := &model.Auth{UserID: "dummy-requester", Role: model.Requester}
+	newContext := func(auth *model.Auth) context.Context {
+		return context.WithValue(context.WithValue(context.Background(), middleware.RequestIDKey, requestID), middleware.AuthKey, auth)
+	}
+
+	tests := []struct {
+		name     string
+		auth     *model.Auth
+		method   string
+		body     string
+		callFunc func()
+		want     int
+	}{
+		{
+			name:   "create by non-administrator",
+			auth:   requester,
+			method: http.MethodPost,
+			body:   `{"groupCode":"G001"}`,
+			want:   http.StatusForbidden,
+		},
+		{
+			name:   "create without group code",
+			auth:   admin,
+			method: http.MethodPost,
+			body:   `{}`,
+			want:   http.StatusBadRequest,
+		},
+		{
+			name:   "create",
+			auth:   admin,
+			method: http.MethodPost,
+			body:   `{"groupCode":"G001"}`,
+			callFunc: func() {
+				service.EXPECT().CreateMaterialTypeGroup(gomock.Any(), model.MaterialTypeGroup{TypeCode: "ERSA", GroupCode: "G001"}).Return(nil)
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name:   "create existing link",
+			auth:   admin,
+			method: http.MethodPost,
+			body:   `{"groupCode":"G001"}`,
+			callFunc: func() {
+				service.EXPECT().CreateMaterialTypeGroup(gomock.Any(), gomock.Any()).Return(errors.New(errors.MaterialTypeGroupAlreadyExists))
+			},
+			want: http.StatusConflict,
+		},
+		{
+			name:   "list",
+			auth:   requester,
+			method: http.MethodGet,
+			callFunc: func() {
+				service.EXPECT().ListMaterialTypeGroups(gomock.Any(), "ERSA").Return([]*model.MaterialGroup{{Code: "G001"}}, nil)
+			},
+			want: http.StatusOK,
+		},
+		{
+			name:   "delete by non-administrator",
+			auth:   requester,
+			method: http.MethodDelete,
+			want:   http.StatusForbidden,
+		},
+		{
+			name:   "delete",
+			auth:   admin,
+			method: http.MethodDelete,
+			callFunc: func() {
+				service.EXPECT().DeleteMaterialTypeGroup(gomock.Any(), model.MaterialTypeGroup{TypeCode: "ERSA", GroupCode: "G001"}).Return(nil)
+			},
+			want: http.StatusNoContent,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.callFunc != nil {
+				test.callFunc()
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(newContext(test.auth), test.method, "/material_types/ERSA/material_groups", strings.NewReader(test.body))
+			r.SetPathValue("code", "ERSA")
+			switch test.method {
+			case http.MethodPost:
+				handler.CreateMaterialTypeGroup(w, r)
+			case http.MethodGet:
+				handler.ListMaterialTypeGroups(w, r)
+			case http.MethodDelete:
+				r.SetPathValue("groupCode", "G001")
+				handler.DeleteMaterialTypeGroup(w, r)
+			}
+
+			if test.want != w.Code {
+				t.Errorf("want: %v, got: %v", test.want, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,842 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./handler.go
+
+// Package handler is a generated GoMock package.
+package handler
+
+import (
+	context "context"
+	io "io"
+	multipart "mime/multipart"
+	reflect "reflect"
+
+	model "github.com/dev-pt-bai/cataloging/internal/model"
+	errors "github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// BulkCreateManufacturers mocks base method.
+func (m *MockService) BulkCreateManufacturers(ctx context.Context, file multipart.File, filename string, mode model.BulkImportMode) (*model.BulkImportReport, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkCreateManufacturers", ctx, file, filename, mode)
+	ret0, _ := ret[0].(*model.BulkImportReport)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// BulkCreateManufacturers indicates an expected call of BulkCreateManufacturers.
+func (mr *MockServiceMockRecorder) BulkCreateManufacturers(ctx, file, filename, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreateManufacturers", reflect.TypeOf((*MockService)(nil).BulkCreateManufacturers), ctx, file, filename, mode)
+}
+
+// BulkCreateMaterialGroups mocks base method.
+func (m *MockService) BulkCreateMaterialGroups(ctx context.Context, file multipart.File, filename string, mode model.BulkImportMode) (*model.BulkImportReport, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkCreateMaterialGroups", ctx, file, filename, mode)
+	ret0, _ := ret[0].(*model.BulkImportReport)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// BulkCreateMaterialGroups indicates an expected call of BulkCreateMaterialGroups.
+func (mr *MockServiceMockRecorder) BulkCreateMaterialGroups(ctx, file, filename, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreateMaterialGroups", reflect.TypeOf((*MockService)(nil).BulkCreateMaterialGroups), ctx, file, filename, mode)
+}
+
+// BulkCreateMaterialTypes mocks base method.
+func (m *MockService) BulkCreateMaterialTypes(ctx context.Context, file multipart.File, filename string, mode model.BulkImportMode) (*model.BulkImportReport, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkCreateMaterialTypes", ctx, file, filename, mode)
+	ret0, _ := ret[0].(*model.BulkImportReport)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// BulkCreateMaterialTypes indicates an expected call of BulkCreateMaterialTypes.
+func (mr *MockServiceMockRecorder) BulkCreateMaterialTypes(ctx, file, filename, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreateMaterialTypes", reflect.TypeOf((*MockService)(nil).BulkCreateMaterialTypes), ctx, file, filename, mode)
+}
+
+// BulkCreateMaterialUoMs mocks base method.
+func (m *MockService) BulkCreateMaterialUoMs(ctx context.Context, file multipart.File, filename string, mode model.BulkImportMode) (*model.BulkImportReport, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkCreateMaterialUoMs", ctx, file, filename, mode)
+	ret0, _ := ret[0].(*model.BulkImportReport)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// BulkCreateMaterialUoMs indicates an expected call of BulkCreateMaterialUoMs.
+func (mr *MockServiceMockRecorder) BulkCreateMaterialUoMs(ctx, file, filename, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreateMaterialUoMs", reflect.TypeOf((*MockService)(nil).BulkCreateMaterialUoMs), ctx, file, filename, mode)
+}
+
+// BulkCreatePlants mocks base method.
+func (m *MockService) BulkCreatePlants(ctx context.Context, file multipart.File, filename string, mode model.BulkImportMode) (*model.BulkImportReport, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkCreatePlants", ctx, file, filename, mode)
+	ret0, _ := ret[0].(*model.BulkImportReport)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// BulkCreatePlants indicates an expected call of BulkCreatePlants.
+func (mr *MockServiceMockRecorder) BulkCreatePlants(ctx, file, filename, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreatePlants", reflect.TypeOf((*MockService)(nil).BulkCreatePlants), ctx, file, filename, mode)
+}
+
+// CommitImportJob mocks base method.
+func (m *MockService) CommitImportJob(ctx context.Context, ID model.UUID) (*model.ImportJob, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitImportJob", ctx, ID)
+	ret0, _ := ret[0].(*model.ImportJob)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// CommitImportJob indicates an expected call of CommitImportJob.
+func (mr *MockServiceMockRecorder) CommitImportJob(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitImportJob", reflect.TypeOf((*MockService)(nil).CommitImportJob), ctx, ID)
+}
+
+// CreateAbbreviation mocks base method.
+func (m *MockService) CreateAbbreviation(ctx context.Context, a model.Abbreviation) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAbbreviation", ctx, a)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// CreateAbbreviation indicates an expected call of CreateAbbreviation.
+func (mr *MockServiceMockRecorder) CreateAbbreviation(ctx, a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAbbreviation", reflect.TypeOf((*MockService)(nil).CreateAbbreviation), ctx, a)
+}
+
+// CreateImportJob mocks base method.
+func (m *MockService) CreateImportJob(ctx context.Context, file io.Reader, filename string, r model.CreateImportJobRequest, requestedBy *model.Auth) (*model.ImportJob, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImportJob", ctx, file, filename, r, requestedBy)
+	ret0, _ := ret[0].(*model.ImportJob)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// CreateImportJob indicates an expected call of CreateImportJob.
+func (mr *MockServiceMockRecorder) CreateImportJob(ctx, file, filename, r, requestedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImportJob", reflect.TypeOf((*MockService)(nil).CreateImportJob), ctx, file, filename, r, requestedBy)
+}
+
+// CreateManufacturer mocks base method.
+func (m_2 *MockService) CreateManufacturer(ctx context.Context, m model.Manufacturer) *errors.Error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "CreateManufacturer", ctx, m)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// CreateManufacturer indicates an expected call of CreateManufacturer.
+func (mr *MockServiceMockRecorder) CreateManufacturer(ctx, m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManufacturer", reflect.TypeOf((*MockService)(nil).CreateManufacturer), ctx, m)
+}
+
+// CreateMaterialGroup mocks base method.
+func (m *MockService) CreateMaterialGroup(ctx context.Context, mg model.MaterialGroup) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMaterialGroup", ctx, mg)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// CreateMaterialGroup indicates an expected call of CreateMaterialGroup.
+func (mr *MockServiceMockRecorder) CreateMaterialGroup(ctx, mg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMaterialGroup", reflect.TypeOf((*MockService)(nil).CreateMaterialGroup), ctx, mg)
+}
+
+// CreateMaterialType mocks base method.
+func (m *MockService) CreateMaterialType(ctx context.Context, mt model.MaterialType) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMaterialType", ctx, mt)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// CreateMaterialType indicates an expected call of CreateMaterialType.
+func (mr *MockServiceMockRecorder) CreateMaterialType(ctx, mt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMaterialType", reflect.TypeOf((*MockService)(nil).CreateMaterialType), ctx, mt)
+}
+
+// CreateMaterialTypeGroup mocks base method.
+func (m *MockService) CreateMaterialTypeGroup(ctx context.Context, mtg model.MaterialTypeGroup) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMaterialTypeGroup", ctx, mtg)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// CreateMaterialTypeGroup indicates an expected call of CreateMaterialTypeGroup.
+func (mr *MockServiceMockRecorder) CreateMaterialTypeGroup(ctx, mtg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMaterialTypeGroup", reflect.TypeOf((*MockService)(nil).CreateMaterialTypeGroup), ctx, mtg)
+}
+
+// CreateMaterialUoM mocks base method.
+func (m *MockService) CreateMaterialUoM(ctx context.Context, uom model.MaterialUoM) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMaterialUoM", ctx, uom)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// CreateMaterialUoM indicates an expected call of CreateMaterialUoM.
+func (mr *MockServiceMockRecorder) CreateMaterialUoM(ctx, uom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMaterialUoM", reflect.TypeOf((*MockService)(nil).CreateMaterialUoM), ctx, uom)
+}
+
+// CreatePlant mocks base method.
+func (m *MockService) CreatePlant(ctx context.Context, p model.Plant) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlant", ctx, p)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// CreatePlant indicates an expected call of CreatePlant.
+func (mr *MockServiceMockRecorder) CreatePlant(ctx, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlant", reflect.TypeOf((*MockService)(nil).CreatePlant), ctx, p)
+}
+
+// CreatePlantMaterialType mocks base method.
+func (m *MockService) CreatePlantMaterialType(ctx context.Context, pmt model.PlantMaterialType) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlantMaterialType", ctx, pmt)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// CreatePlantMaterialType indicates an expected call of CreatePlantMaterialType.
+func (mr *MockServiceMockRecorder) CreatePlantMaterialType(ctx, pmt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlantMaterialType", reflect.TypeOf((*MockService)(nil).CreatePlantMaterialType), ctx, pmt)
+}
+
+// DeleteAbbreviation mocks base method.
+func (m *MockService) DeleteAbbreviation(ctx context.Context, term string) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAbbreviation", ctx, term)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// DeleteAbbreviation indicates an expected call of DeleteAbbreviation.
+func (mr *MockServiceMockRecorder) DeleteAbbreviation(ctx, term interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAbbreviation", reflect.TypeOf((*MockService)(nil).DeleteAbbreviation), ctx, term)
+}
+
+// DeleteManufacturer mocks base method.
+func (m *MockService) DeleteManufacturer(ctx context.Context, code string) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManufacturer", ctx, code)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// DeleteManufacturer indicates an expected call of DeleteManufacturer.
+func (mr *MockServiceMockRecorder) DeleteManufacturer(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManufacturer", reflect.TypeOf((*MockService)(nil).DeleteManufacturer), ctx, code)
+}
+
+// DeleteMaterialGroup mocks base method.
+func (m *MockService) DeleteMaterialGroup(ctx context.Context, code string) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaterialGroup", ctx, code)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// DeleteMaterialGroup indicates an expected call of DeleteMaterialGroup.
+func (mr *MockServiceMockRecorder) DeleteMaterialGroup(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaterialGroup", reflect.TypeOf((*MockService)(nil).DeleteMaterialGroup), ctx, code)
+}
+
+// DeleteMaterialType mocks base method.
+func (m *MockService) DeleteMaterialType(ctx context.Context, code string) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaterialType", ctx, code)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// DeleteMaterialType indicates an expected call of DeleteMaterialType.
+func (mr *MockServiceMockRecorder) DeleteMaterialType(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaterialType", reflect.TypeOf((*MockService)(nil).DeleteMaterialType), ctx, code)
+}
+
+// DeleteMaterialTypeGroup mocks base method.
+func (m *MockService) DeleteMaterialTypeGroup(ctx context.Context, mtg model.MaterialTypeGroup) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaterialTypeGroup", ctx, mtg)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// DeleteMaterialTypeGroup indicates an expected call of DeleteMaterialTypeGroup.
+func (mr *MockServiceMockRecorder) DeleteMaterialTypeGroup(ctx, mtg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaterialTypeGroup", reflect.TypeOf((*MockService)(nil).DeleteMaterialTypeGroup), ctx, mtg)
+}
+
+// DeleteMaterialUoM mocks base method.
+func (m *MockService) DeleteMaterialUoM(ctx context.Context, code string) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaterialUoM", ctx, code)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// DeleteMaterialUoM indicates an expected call of DeleteMaterialUoM.
+func (mr *MockServiceMockRecorder) DeleteMaterialUoM(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaterialUoM", reflect.TypeOf((*MockService)(nil).DeleteMaterialUoM), ctx, code)
+}
+
+// DeletePlant mocks base method.
+func (m *MockService) DeletePlant(ctx context.Context, code string) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlant", ctx, code)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// DeletePlant indicates an expected call of DeletePlant.
+func (mr *MockServiceMockRecorder) DeletePlant(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlant", reflect.TypeOf((*MockService)(nil).DeletePlant), ctx, code)
+}
+
+// DeletePlantMaterialType mocks base method.
+func (m *MockService) DeletePlantMaterialType(ctx context.Context, pmt model.PlantMaterialType) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlantMaterialType", ctx, pmt)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// DeletePlantMaterialType indicates an expected call of DeletePlantMaterialType.
+func (mr *MockServiceMockRecorder) DeletePlantMaterialType(ctx, pmt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlantMaterialType", reflect.TypeOf((*MockService)(nil).DeletePlantMaterialType), ctx, pmt)
+}
+
+// ExportBulkImportReport mocks base method.
+func (m *MockService) ExportBulkImportReport(report *model.BulkImportReport) ([]byte, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportBulkImportReport", report)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ExportBulkImportReport indicates an expected call of ExportBulkImportReport.
+func (mr *MockServiceMockRecorder) ExportBulkImportReport(report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBulkImportReport", reflect.TypeOf((*MockService)(nil).ExportBulkImportReport), report)
+}
+
+// ExportManufacturers mocks base method.
+func (m *MockService) ExportManufacturers(ctx context.Context) ([]byte, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportManufacturers", ctx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ExportManufacturers indicates an expected call of ExportManufacturers.
+func (mr *MockServiceMockRecorder) ExportManufacturers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportManufacturers", reflect.TypeOf((*MockService)(nil).ExportManufacturers), ctx)
+}
+
+// ExportMaterialGroups mocks base method.
+func (m *MockService) ExportMaterialGroups(ctx context.Context) ([]byte, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportMaterialGroups", ctx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ExportMaterialGroups indicates an expected call of ExportMaterialGroups.
+func (mr *MockServiceMockRecorder) ExportMaterialGroups(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportMaterialGroups", reflect.TypeOf((*MockService)(nil).ExportMaterialGroups), ctx)
+}
+
+// ExportMaterialTypes mocks base method.
+func (m *MockService) ExportMaterialTypes(ctx context.Context) ([]byte, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportMaterialTypes", ctx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ExportMaterialTypes indicates an expected call of ExportMaterialTypes.
+func (mr *MockServiceMockRecorder) ExportMaterialTypes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportMaterialTypes", reflect.TypeOf((*MockService)(nil).ExportMaterialTypes), ctx)
+}
+
+// ExportMaterialUoMs mocks base method.
+func (m *MockService) ExportMaterialUoMs(ctx context.Context) ([]byte, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportMaterialUoMs", ctx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ExportMaterialUoMs indicates an expected call of ExportMaterialUoMs.
+func (mr *MockServiceMockRecorder) ExportMaterialUoMs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportMaterialUoMs", reflect.TypeOf((*MockService)(nil).ExportMaterialUoMs), ctx)
+}
+
+// ExportPlants mocks base method.
+func (m *MockService) ExportPlants(ctx context.Context) ([]byte, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportPlants", ctx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ExportPlants indicates an expected call of ExportPlants.
+func (mr *MockServiceMockRecorder) ExportPlants(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportPlants", reflect.TypeOf((*MockService)(nil).ExportPlants), ctx)
+}
+
+// ExportRequestTemplate mocks base method.
+func (m *MockService) ExportRequestTemplate(ctx context.Context) ([]byte, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportRequestTemplate", ctx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ExportRequestTemplate indicates an expected call of ExportRequestTemplate.
+func (mr *MockServiceMockRecorder) ExportRequestTemplate(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportRequestTemplate", reflect.TypeOf((*MockService)(nil).ExportRequestTemplate), ctx)
+}
+
+// GetAbbreviation mocks base method.
+func (m *MockService) GetAbbreviation(ctx context.Context, term string) (*model.Abbreviation, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAbbreviation", ctx, term)
+	ret0, _ := ret[0].(*model.Abbreviation)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// GetAbbreviation indicates an expected call of GetAbbreviation.
+func (mr *MockServiceMockRecorder) GetAbbreviation(ctx, term interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAbbreviation", reflect.TypeOf((*MockService)(nil).GetAbbreviation), ctx, term)
+}
+
+// GetImportJob mocks base method.
+func (m *MockService) GetImportJob(ctx context.Context, ID model.UUID) (*model.ImportJob, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportJob", ctx, ID)
+	ret0, _ := ret[0].(*model.ImportJob)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// GetImportJob indicates an expected call of GetImportJob.
+func (mr *MockServiceMockRecorder) GetImportJob(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportJob", reflect.TypeOf((*MockService)(nil).GetImportJob), ctx, ID)
+}
+
+// GetManufacturer mocks base method.
+func (m *MockService) GetManufacturer(ctx context.Context, code string) (*model.Manufacturer, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManufacturer", ctx, code)
+	ret0, _ := ret[0].(*model.Manufacturer)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// GetManufacturer indicates an expected call of GetManufacturer.
+func (mr *MockServiceMockRecorder) GetManufacturer(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManufacturer", reflect.TypeOf((*MockService)(nil).GetManufacturer), ctx, code)
+}
+
+// GetMaterial mocks base method.
+func (m *MockService) GetMaterial(ctx context.Context, ID model.UUID) (*model.Material, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaterial", ctx, ID)
+	ret0, _ := ret[0].(*model.Material)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// GetMaterial indicates an expected call of GetMaterial.
+func (mr *MockServiceMockRecorder) GetMaterial(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterial", reflect.TypeOf((*MockService)(nil).GetMaterial), ctx, ID)
+}
+
+// GetMaterialGroup mocks base method.
+func (m *MockService) GetMaterialGroup(ctx context.Context, code string) (*model.MaterialGroup, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaterialGroup", ctx, code)
+	ret0, _ := ret[0].(*model.MaterialGroup)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// GetMaterialGroup indicates an expected call of GetMaterialGroup.
+func (mr *MockServiceMockRecorder) GetMaterialGroup(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterialGroup", reflect.TypeOf((*MockService)(nil).GetMaterialGroup), ctx, code)
+}
+
+// GetMaterialType mocks base method.
+func (m *MockService) GetMaterialType(ctx context.Context, code string) (*model.MaterialType, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaterialType", ctx, code)
+	ret0, _ := ret[0].(*model.MaterialType)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// GetMaterialType indicates an expected call of GetMaterialType.
+func (mr *MockServiceMockRecorder) GetMaterialType(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterialType", reflect.TypeOf((*MockService)(nil).GetMaterialType), ctx, code)
+}
+
+// GetMaterialUoM mocks base method.
+func (m *MockService) GetMaterialUoM(ctx context.Context, code string) (*model.MaterialUoM, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaterialUoM", ctx, code)
+	ret0, _ := ret[0].(*model.MaterialUoM)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// GetMaterialUoM indicates an expected call of GetMaterialUoM.
+func (mr *MockServiceMockRecorder) GetMaterialUoM(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaterialUoM", reflect.TypeOf((*MockService)(nil).GetMaterialUoM), ctx, code)
+}
+
+// GetPlant mocks base method.
+func (m *MockService) GetPlant(ctx context.Context, code string) (*model.Plant, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlant", ctx, code)
+	ret0, _ := ret[0].(*model.Plant)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// GetPlant indicates an expected call of GetPlant.
+func (mr *MockServiceMockRecorder) GetPlant(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlant", reflect.TypeOf((*MockService)(nil).GetPlant), ctx, code)
+}
+
+// ListAbbreviations mocks base method.
+func (m *MockService) ListAbbreviations(ctx context.Context, criteria model.ListAbbreviationsCriteria) (*model.Abbreviations, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAbbreviations", ctx, criteria)
+	ret0, _ := ret[0].(*model.Abbreviations)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListAbbreviations indicates an expected call of ListAbbreviations.
+func (mr *MockServiceMockRecorder) ListAbbreviations(ctx, criteria interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAbbreviations", reflect.TypeOf((*MockService)(nil).ListAbbreviations), ctx, criteria)
+}
+
+// ListManufacturers mocks base method.
+func (m *MockService) ListManufacturers(ctx context.Context, criteria model.ListManufacturersCriteria) (*model.Manufacturers, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListManufacturers", ctx, criteria)
+	ret0, _ := ret[0].(*model.Manufacturers)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListManufacturers indicates an expected call of ListManufacturers.
+func (mr *MockServiceMockRecorder) ListManufacturers(ctx, criteria interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManufacturers", reflect.TypeOf((*MockService)(nil).ListManufacturers), ctx, criteria)
+}
+
+// ListMaterialGroups mocks base method.
+func (m *MockService) ListMaterialGroups(ctx context.Context, criteria model.ListMaterialGroupsCriteria) (*model.MaterialGroups, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMaterialGroups", ctx, criteria)
+	ret0, _ := ret[0].(*model.MaterialGroups)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListMaterialGroups indicates an expected call of ListMaterialGroups.
+func (mr *MockServiceMockRecorder) ListMaterialGroups(ctx, criteria interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMaterialGroups", reflect.TypeOf((*MockService)(nil).ListMaterialGroups), ctx, criteria)
+}
+
+// ListMaterialTypeGroups mocks base method.
+func (m *MockService) ListMaterialTypeGroups(ctx context.Context, typeCode string) ([]*model.MaterialGroup, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMaterialTypeGroups", ctx, typeCode)
+	ret0, _ := ret[0].([]*model.MaterialGroup)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListMaterialTypeGroups indicates an expected call of ListMaterialTypeGroups.
+func (mr *MockServiceMockRecorder) ListMaterialTypeGroups(ctx, typeCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMaterialTypeGroups", reflect.TypeOf((*MockService)(nil).ListMaterialTypeGroups), ctx, typeCode)
+}
+
+// ListMaterialTypes mocks base method.
+func (m *MockService) ListMaterialTypes(ctx context.Context, criteria model.ListMaterialTypesCriteria) (*model.MaterialTypes, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMaterialTypes", ctx, criteria)
+	ret0, _ := ret[0].(*model.MaterialTypes)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListMaterialTypes indicates an expected call of ListMaterialTypes.
+func (mr *MockServiceMockRecorder) ListMaterialTypes(ctx, criteria interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMaterialTypes", reflect.TypeOf((*MockService)(nil).ListMaterialTypes), ctx, criteria)
+}
+
+// ListMaterialUoMs mocks base method.
+func (m *MockService) ListMaterialUoMs(ctx context.Context, criteria model.ListMaterialUoMsCriteria) (*model.MaterialUoMs, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMaterialUoMs", ctx, criteria)
+	ret0, _ := ret[0].(*model.MaterialUoMs)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListMaterialUoMs indicates an expected call of ListMaterialUoMs.
+func (mr *MockServiceMockRecorder) ListMaterialUoMs(ctx, criteria interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMaterialUoMs", reflect.TypeOf((*MockService)(nil).ListMaterialUoMs), ctx, criteria)
+}
+
+// ListMaterials mocks base method.
+func (m *MockService) ListMaterials(ctx context.Context, criteria model.ListMaterialsCriteria) (*model.Materials, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMaterials", ctx, criteria)
+	ret0, _ := ret[0].(*model.Materials)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListMaterials indicates an expected call of ListMaterials.
+func (mr *MockServiceMockRecorder) ListMaterials(ctx, criteria interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMaterials", reflect.TypeOf((*MockService)(nil).ListMaterials), ctx, criteria)
+}
+
+// ListMaterialsByNumber mocks base method.
+func (m *MockService) ListMaterialsByNumber(ctx context.Context, number string) ([]*model.Material, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMaterialsByNumber", ctx, number)
+	ret0, _ := ret[0].([]*model.Material)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListMaterialsByNumber indicates an expected call of ListMaterialsByNumber.
+func (mr *MockServiceMockRecorder) ListMaterialsByNumber(ctx, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMaterialsByNumber", reflect.TypeOf((*MockService)(nil).ListMaterialsByNumber), ctx, number)
+}
+
+// ListPlantMaterialTypes mocks base method.
+func (m *MockService) ListPlantMaterialTypes(ctx context.Context, plantCode string) ([]*model.MaterialType, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlantMaterialTypes", ctx, plantCode)
+	ret0, _ := ret[0].([]*model.MaterialType)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListPlantMaterialTypes indicates an expected call of ListPlantMaterialTypes.
+func (mr *MockServiceMockRecorder) ListPlantMaterialTypes(ctx, plantCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlantMaterialTypes", reflect.TypeOf((*MockService)(nil).ListPlantMaterialTypes), ctx, plantCode)
+}
+
+// ListPlants mocks base method.
+func (m *MockService) ListPlants(ctx context.Context, criteria model.ListPlantsCriteria) (*model.Plants, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlants", ctx, criteria)
+	ret0, _ := ret[0].(*model.Plants)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListPlants indicates an expected call of ListPlants.
+func (mr *MockServiceMockRecorder) ListPlants(ctx, criteria interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlants", reflect.TypeOf((*MockService)(nil).ListPlants), ctx, criteria)
+}
+
+// PreviewShortText mocks base method.
+func (m *MockService) PreviewShortText(ctx context.Context, longText string, shortText *string) (*model.ShortTextPreview, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewShortText", ctx, longText, shortText)
+	ret0, _ := ret[0].(*model.ShortTextPreview)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// PreviewShortText indicates an expected call of PreviewShortText.
+func (mr *MockServiceMockRecorder) PreviewShortText(ctx, longText, shortText interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewShortText", reflect.TypeOf((*MockService)(nil).PreviewShortText), ctx, longText, shortText)
+}
+
+// UpdateAbbreviation mocks base method.
+func (m *MockService) UpdateAbbreviation(ctx context.Context, a model.Abbreviation) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAbbreviation", ctx, a)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// UpdateAbbreviation indicates an expected call of UpdateAbbreviation.
+func (mr *MockServiceMockRecorder) UpdateAbbreviation(ctx, a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAbbreviation", reflect.TypeOf((*MockService)(nil).UpdateAbbreviation), ctx, a)
+}
+
+// UpdateManufacturer mocks base method.
+func (m_2 *MockService) UpdateManufacturer(ctx context.Context, m model.Manufacturer) *errors.Error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "UpdateManufacturer", ctx, m)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// UpdateManufacturer indicates an expected call of UpdateManufacturer.
+func (mr *MockServiceMockRecorder) UpdateManufacturer(ctx, m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManufacturer", reflect.TypeOf((*MockService)(nil).UpdateManufacturer), ctx, m)
+}
+
+// UpdateMaterialGroup mocks base method.
+func (m *MockService) UpdateMaterialGroup(ctx context.Context, mg model.MaterialGroup) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMaterialGroup", ctx, mg)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// UpdateMaterialGroup indicates an expected call of UpdateMaterialGroup.
+func (mr *MockServiceMockRecorder) UpdateMaterialGroup(ctx, mg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaterialGroup", reflect.TypeOf((*MockService)(nil).UpdateMaterialGroup), ctx, mg)
+}
+
+// UpdateMaterialType mocks base method.
+func (m *MockService) UpdateMaterialType(ctx context.Context, mt model.MaterialType) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMaterialType", ctx, mt)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// UpdateMaterialType indicates an expected call of UpdateMaterialType.
+func (mr *MockServiceMockRecorder) UpdateMaterialType(ctx, mt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaterialType", reflect.TypeOf((*MockService)(nil).UpdateMaterialType), ctx, mt)
+}
+
+// UpdateMaterialUoM mocks base method.
+func (m *MockService) UpdateMaterialUoM(ctx context.Context, uom model.MaterialUoM) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMaterialUoM", ctx, uom)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// UpdateMaterialUoM indicates an expected call of UpdateMaterialUoM.
+func (mr *MockServiceMockRecorder) UpdateMaterialUoM(ctx, uom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMaterialUoM", reflect.TypeOf((*MockService)(nil).UpdateMaterialUoM), ctx, uom)
+}
+
+// UpdatePlant mocks base method.
+func (m *MockService) UpdatePlant(ctx context.Context, p model.Plant) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlant", ctx, p)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// UpdatePlant indicates an expected call of UpdatePlant.
+func (mr *MockServiceMockRecorder) UpdatePlant(ctx, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlant", reflect.TypeOf((*MockService)(nil).UpdatePlant), ctx, p)
+}
@@ -3,10 +3,13 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/dev-pt-bai/cataloging/internal/model"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/database/query"
 	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
 )
 
@@ -22,22 +25,47 @@ const CreateMaterialTypeQuery = `
 INSERT INTO material_types (code, description, val_class)
 	VALUES (?, ?, ?)`
 
+const UpsertMaterialTypeQuery = `
+INSERT INTO material_types (code, description, val_class)
+	VALUES (?, ?, ?)
+	ON DUPLICATE KEY UPDATE updated_at = IF(description <=> VALUES(description) AND val_class <=> VALUES(val_class), updated_at, (UNIX_TIMESTAMP())), description = VALUES(description), val_class = VALUES(val_class)`
+
 const CreateMaterialUoMQuery = `
 INSERT INTO material_uoms (code, description)
 	VALUES (?, ?)`
 
+const UpsertMaterialUoMQuery = `
+INSERT INTO material_uoms (code, description)
+	VALUES (?, ?)
+	ON DUPLICATE KEY UPDATE updated_at = IF(description <=> VALUES(description), updated_at, (UNIX_TIMESTAMP())), description = VALUES(description)`
+
 const CreateMaterialGroupQuery = `
 INSERT INTO material_groups (code, description)
 	VALUES (?, ?)`
 
+const UpsertMaterialGroupQuery = `
+INSERT INTO material_groups (code, description)
+	VALUES (?, ?)
+	ON DUPLICATE KEY UPDATE updated_at = IF(description <=> VALUES(description), updated_at, (UNIX_TIMESTAMP())), description = VALUES(description)`
+
 const CreatePlantQuery = `
 INSERT INTO plants (code, description)
 	VALUES (?, ?)`
 
+const UpsertPlantQuery = `
+INSERT INTO plants (code, description)
+	VALUES (?, ?)
+	ON DUPLICATE KEY UPDATE updated_at = IF(description <=> VALUES(description), updated_at, (UNIX_TIMESTAMP())), description = VALUES(description)`
+
 const CreateManufacturerQuery = `
 INSERT INTO manufacturers (code, description)
 	VALUES (?, ?)`
 
+const UpsertManufacturerQuery = `
+INSERT INTO manufacturers (code, description)
+	VALUES (?, ?)
+	ON DUPLICATE KEY UPDATE updated_at = IF(description <=> VALUES(description), updated_at, (UNIX_TIMESTAMP())), description = VALUES(description)`
+
 const ListMaterialTypeQuery = `
 WITH
 	cte1 AS (SELECT JSON_OBJECT('code', code, 'description', description, 'valuationClass', val_class, 'createdAt', created_at, 'updatedAt', updated_at) AS record FROM material_types `
@@ -58,6 +86,36 @@ const ListManufacturerQuery = `
 WITH
 	cte1 AS (SELECT JSON_OBJECT('code', code, 'description', description, 'createdAt', created_at, 'updatedAt', updated_at) AS record FROM manufacturers `
 
+const ListAllMaterialTypesQuery = `
+SELECT code, description, val_class, created_at, updated_at
+	FROM material_types
+	WHERE deleted_at = 0
+	ORDER BY code`
+
+const ListAllMaterialUoMsQuery = `
+SELECT code, description, created_at, updated_at
+	FROM material_uoms
+	WHERE deleted_at = 0
+	ORDER BY code`
+
+const ListAllMaterialGroupsQuery = `
+SELECT code, description, created_at, updated_at
+	FROM material_groups
+	WHERE deleted_at = 0
+	ORDER BY code`
+
+const ListAllPlantsQuery = `
+SELECT code, description, created_at, updated_at
+	FROM plants
+	WHERE deleted_at = 0
+	ORDER BY code`
+
+const ListAllManufacturersQuery = `
+SELECT code, description, created_at, updated_at
+	FROM manufacturers
+	WHERE deleted_at = 0
+	ORDER BY code`
+
 const GetMaterialTypeQuery = `
 SELECT code, description, val_class, created_at, updated_at
 	FROM material_types
@@ -119,10 +177,141 @@ const DeletePlantQuery = `
 UPDATE plants SET deleted_at = (UNIX_TIMESTAMP())
 	WHERE code = ?`
 
+const DeletePlantMaterialTypesByPlantQuery = `
+DELETE FROM plant_material_types
+	WHERE plant_code = ?`
+
+const DeletePlantMaterialTypesByTypeQuery = `
+DELETE FROM plant_material_types
+	WHERE type_code = ?`
+
+const DeleteMaterialTypeGroupsByTypeQuery = `
+DELETE FROM material_type_groups
+	WHERE type_code = ?`
+
+const DeleteMaterialTypeGroupsByGroupQuery = `
+DELETE FROM material_type_groups
+	WHERE group_code = ?`
+
 const DeleteManufacturerQuery = `
 UPDATE manufacturers SET deleted_at = (UNIX_TIMESTAMP())
 	WHERE code = ?`
 
+const ListMaterialQuery = `
+WITH
+	cte1 AS (SELECT ` + query.MaterialRecord + ` AS record FROM materials `
+
+const GetMaterialQuery = `
+SELECT ` + query.MaterialRecord + `
+	FROM materials
+	WHERE id = ? AND status <> ? AND deleted_at = 0`
+
+const ListMaterialsByNumberQuery = `
+SELECT ` + query.MaterialRecord + `
+	FROM materials
+	WHERE number = ? AND status = ? AND deleted_at = 0
+	ORDER BY plant_code`
+
+const CreatePlantMaterialTypeQuery = `
+INSERT INTO plant_material_types (plant_code, type_code)
+	SELECT ?, ?
+	WHERE EXISTS(SELECT 1 FROM plants WHERE code = ? AND deleted_at = 0)
+	AND EXISTS(SELECT 1 FROM material_types WHERE code = ? AND deleted_at = 0)`
+
+const ListPlantMaterialTypesQuery = `
+SELECT mt.code, mt.description, mt.val_class, mt.created_at, mt.updated_at
+	FROM plant_material_types pmt
+	JOIN material_types mt ON pmt.type_code = mt.code AND mt.deleted_at = 0
+	WHERE pmt.plant_code = ?
+	ORDER BY mt.code`
+
+const DeletePlantMaterialTypeQuery = `
+DELETE FROM plant_material_types
+	WHERE plant_code = ? AND type_code = ?`
+
+const CreateMaterialTypeGroupQuery = `
+INSERT INTO material_type_groups (type_code, group_code)
+	SELECT ?, ?
+	WHERE EXISTS(SELECT 1 FROM material_types WHERE code = ? AND deleted_at = 0)
+	AND EXISTS(SELECT 1 FROM material_groups WHERE code = ? AND deleted_at = 0)`
+
+const ListMaterialTypeGroupsQuery = `
+SELECT mg.code, mg.description, mg.created_at, mg.updated_at
+	FROM material_type_groups mtg
+	JOIN material_groups mg ON mtg.group_code = mg.code AND mg.deleted_at = 0
+	WHERE mtg.type_code = ?
+	ORDER BY mg.code`
+
+const DeleteMaterialTypeGroupQuery = `
+DELETE FROM material_type_groups
+	WHERE type_code = ? AND group_code = ?`
+
+const CreateImportJobQuery = `
+INSERT INTO import_jobs (id, entity, mode, dry_run, status, filename, file_path, created_by)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+const GetImportJobQuery = `
+SELECT id, entity, mode, dry_run, status, filename, processed_rows, report, error_code, created_by, created_at, updated_at
+	FROM import_jobs
+	WHERE id = ?`
+
+const GetImportJobFileQuery = `
+SELECT file_path
+	FROM import_jobs
+	WHERE id = ? AND file_path IS NOT NULL`
+
+const StartImportJobQuery = `
+UPDATE import_jobs SET status = ?, processed_rows = 0, updated_at = (UNIX_TIMESTAMP())
+	WHERE id = ? AND status IN (?, ?)`
+
+const UpdateImportJobProgressQuery = `
+UPDATE import_jobs SET processed_rows = ?, updated_at = (UNIX_TIMESTAMP())
+	WHERE id = ?`
+
+const FinishImportJobQuery = `
+UPDATE import_jobs SET status = ?, processed_rows = ?, report = ?, error_code = ?, file_path = IF(?, NULL, file_path), updated_at = (UNIX_TIMESTAMP())
+	WHERE id = ?`
+
+const CommitImportJobQuery = `
+UPDATE import_jobs SET status = ?, dry_run = 0, updated_at = (UNIX_TIMESTAMP())
+	WHERE id = ? AND status = ? AND file_path IS NOT NULL`
+
+const ListExpiredImportJobsQuery = `
+SELECT id, file_path
+	FROM import_jobs
+	WHERE status = ? AND updated_at < ?
+	FOR UPDATE`
+
+const ExpireImportJobQuery = `
+UPDATE import_jobs SET status = ?, file_path = NULL, updated_at = (UNIX_TIMESTAMP())
+	WHERE id = ?`
+
+const CreateAbbreviationQuery = `
+INSERT INTO abbreviations (term, abbreviation)
+	VALUES (?, ?)`
+
+const ListAbbreviationQuery = `
+WITH
+	cte1 AS (SELECT JSON_OBJECT('term', term, 'abbreviation', abbreviation, 'createdAt', created_at, 'updatedAt', updated_at) AS record FROM abbreviations `
+
+const ListAllAbbreviationsQuery = `
+SELECT term, abbreviation, created_at, updated_at
+	FROM abbreviations
+	WHERE deleted_at = 0`
+
+const GetAbbreviationQuery = `
+SELECT term, abbreviation, created_at, updated_at
+	FROM abbreviations
+	WHERE term = ? AND deleted_at = 0`
+
+const UpdateAbbreviationQuery = `
+UPDATE abbreviations SET abbreviation = ?, updated_at = (UNIX_TIMESTAMP())
+	WHERE term = ? AND deleted_at = 0`
+
+const DeleteAbbreviationQuery = `
+UPDATE abbreviations SET deleted_at = (UNIX_TIMESTAMP())
+	WHERE term = ?`
+
 func (r *Repository) CreateMaterialType(ctx context.Context, mt model.MaterialType) *errors.Error {
 	_, err := r.db.ExecContext(ctx, CreateMaterialTypeQuery, mt.Code, mt.Description, mt.ValuationClass)
 	if err != nil {
@@ -183,34 +372,97 @@ func (r *Repository) CreateManufacturer(ctx context.Context, m model.Manufacture
 	return nil
 }
 
-func (r *Repository) BulkCreateManufacturer(ctx context.Context, ms []model.Manufacturer) *errors.Error {
-	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelDefault})
+func (r *Repository) BulkCreateMaterialType(ctx context.Context, mts iter.Seq2[model.MaterialType, *errors.Error], mode model.BulkImportMode, commit func(statuses []model.BulkImportStatus) bool) ([]model.BulkImportStatus, *errors.Error) {
+	return bulkCreate(ctx, r.db, CreateMaterialTypeQuery, UpsertMaterialTypeQuery, mode, commit, mts, func(mt model.MaterialType) []any {
+		return []any{mt.Code, mt.Description, mt.ValuationClass}
+	})
+}
+
+func (r *Repository) BulkCreateMaterialUoM(ctx context.Context, uoms iter.Seq2[model.MaterialUoM, *errors.Error], mode model.BulkImportMode, commit func(statuses []model.BulkImportStatus) bool) ([]model.BulkImportStatus, *errors.Error) {
+	return bulkCreate(ctx, r.db, CreateMaterialUoMQuery, UpsertMaterialUoMQuery, mode, commit, uoms, func(uom model.MaterialUoM) []any {
+		return []any{uom.Code, uom.Description}
+	})
+}
+
+func (r *Repository) BulkCreateMaterialGroup(ctx context.Context, mgs iter.Seq2[model.MaterialGroup, *errors.Error], mode model.BulkImportMode, commit func(statuses []model.BulkImportStatus) bool) ([]model.BulkImportStatus, *errors.Error) {
+	return bulkCreate(ctx, r.db, CreateMaterialGroupQuery, UpsertMaterialGroupQuery, mode, commit, mgs, func(mg model.MaterialGroup) []any {
+		return []any{mg.Code, mg.Description}
+	})
+}
+
+func (r *Repository) BulkCreatePlant(ctx context.Context, ps iter.Seq2[model.Plant, *errors.Error], mode model.BulkImportMode, commit func(statuses []model.BulkImportStatus) bool) ([]model.BulkImportStatus, *errors.Error) {
+	return bulkCreate(ctx, r.db, CreatePlantQuery, UpsertPlantQuery, mode, commit, ps, func(p model.Plant) []any {
+		return []any{p.Code, p.Description}
+	})
+}
+
+func (r *Repository) BulkCreateManufacturer(ctx context.Context, ms iter.Seq2[model.Manufacturer, *errors.Error], mode model.BulkImportMode, commit func(statuses []model.BulkImportStatus) bool) ([]model.BulkImportStatus, *errors.Error) {
+	return bulkCreate(ctx, r.db, CreateManufacturerQuery, UpsertManufacturerQuery, mode, commit, ms, func(m model.Manufacturer) []any {
+		return []any{m.Code, m.Description}
+	})
+}
+
+func bulkCreate[T any](ctx context.Context, db *sql.DB, createQuery string, upsertQuery string, mode model.BulkImportMode, commit func(statuses []model.BulkImportStatus) bool, items iter.Seq2[T, *errors.Error], argsOf func(T) []any) ([]model.BulkImportStatus, *errors.Error) {
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelDefault})
 	if err != nil {
-		return errors.New(errors.StartingTransactionFailure).Wrap(err)
+		return nil, errors.New(errors.StartingTransactionFailure).Wrap(err)
 	}
 	defer tx.Rollback()
 
-	stmt, err := tx.PrepareContext(ctx, CreateManufacturerQuery)
-	if err != nil {
+	query := createQuery
+	if mode == model.BulkUpsert {
+		query = upsertQuery
+	}
 
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, errors.New(errors.PrepareStatementFailure).Wrap(err)
 	}
 	defer stmt.Close()
 
-	for i := range ms {
-		_, err = stmt.ExecContext(ctx, ms[i].Code, ms[i].Description)
+	statuses := make([]model.BulkImportStatus, 0, 100)
+	for item, errItem := range items {
+		if errItem != nil {
+			return nil, errItem
+		}
+
+		res, err := stmt.ExecContext(ctx, argsOf(item)...)
 		if err != nil {
 			if errors.HasMySQLErrCode(err, 1062) {
-				return errors.New(errors.ManufacturerAlreadyExists).Wrap(err)
+				status := model.BulkImportFailed
+				if mode == model.BulkSkipExisting {
+					status = model.BulkImportSkipped
+				}
+				statuses = append(statuses, status)
+				continue
 			}
-			return errors.New(errors.RunQueryFailure).Wrap(err)
+			return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			return nil, errors.New(errors.RowsAffectedFailure).Wrap(err)
 		}
+
+		switch n {
+		case 0:
+			statuses = append(statuses, model.BulkImportUnchanged)
+		case 1:
+			statuses = append(statuses, model.BulkImportCreated)
+		default:
+			statuses = append(statuses, model.BulkImportUpdated)
+		}
+	}
+
+	if !commit(statuses) {
+		return statuses, nil
 	}
 
 	if err = tx.Commit(); err != nil {
-		return errors.New(errors.CommittingTransactionFailure).Wrap(err)
+		return nil, errors.New(errors.CommittingTransactionFailure).Wrap(err)
 	}
 
-	return nil
+	return statuses, nil
 }
 
 func (r *Repository) ListMaterialTypes(ctx context.Context, criteria model.ListMaterialTypesCriteria) (*model.MaterialTypes, *errors.Error) {
@@ -488,6 +740,121 @@ func (r *Repository) paginate(page model.Page, param *listParam) *errors.Error {
 	return nil
 }
 
+func (r *Repository) ListAllMaterialTypes(ctx context.Context) ([]*model.MaterialType, *errors.Error) {
+	rows, err := r.db.QueryContext(ctx, ListAllMaterialTypesQuery)
+	if err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	defer rows.Close()
+
+	mts := make([]*model.MaterialType, 0, 100)
+	for rows.Next() {
+		mt := new(model.MaterialType)
+		if err = rows.Scan(&mt.Code, &mt.Description, &mt.ValuationClass, &mt.CreatedAt, &mt.UpdatedAt); err != nil {
+			return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+		}
+		mts = append(mts, mt)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+	}
+
+	return mts, nil
+}
+
+func (r *Repository) ListAllMaterialUoMs(ctx context.Context) ([]*model.MaterialUoM, *errors.Error) {
+	rows, err := r.db.QueryContext(ctx, ListAllMaterialUoMsQuery)
+	if err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	defer rows.Close()
+
+	uoms := make([]*model.MaterialUoM, 0, 100)
+	for rows.Next() {
+		uom := new(model.MaterialUoM)
+		if err = rows.Scan(&uom.Code, &uom.Description, &uom.CreatedAt, &uom.UpdatedAt); err != nil {
+			return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+		}
+		uoms = append(uoms, uom)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+	}
+
+	return uoms, nil
+}
+
+func (r *Repository) ListAllMaterialGroups(ctx context.Context) ([]*model.MaterialGroup, *errors.Error) {
+	rows, err := r.db.QueryContext(ctx, ListAllMaterialGroupsQuery)
+	if err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	defer rows.Close()
+
+	mgs := make([]*model.MaterialGroup, 0, 100)
+	for rows.Next() {
+		mg := new(model.MaterialGroup)
+		if err = rows.Scan(&mg.Code, &mg.Description, &mg.CreatedAt, &mg.UpdatedAt); err != nil {
+			return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+		}
+		mgs = append(mgs, mg)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+	}
+
+	return mgs, nil
+}
+
+func (r *Repository) ListAllPlants(ctx context.Context) ([]*model.Plant, *errors.Error) {
+	rows, err := r.db.QueryContext(ctx, ListAllPlantsQuery)
+	if err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	defer rows.Close()
+
+	ps := make([]*model.Plant, 0, 100)
+	for rows.Next() {
+		p := new(model.Plant)
+		if err = rows.Scan(&p.Code, &p.Description, &p.CreatedAt, &p.UpdatedAt); err != nil {
+			return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+		}
+		ps = append(ps, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+	}
+
+	return ps, nil
+}
+
+func (r *Repository) ListAllManufacturers(ctx context.Context) ([]*model.Manufacturer, *errors.Error) {
+	rows, err := r.db.QueryContext(ctx, ListAllManufacturersQuery)
+	if err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	defer rows.Close()
+
+	ms := make([]*model.Manufacturer, 0, 100)
+	for rows.Next() {
+		m := new(model.Manufacturer)
+		if err = rows.Scan(&m.Code, &m.Description, &m.CreatedAt, &m.UpdatedAt); err != nil {
+			return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+		}
+		ms = append(ms, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+	}
+
+	return ms, nil
+}
+
 func (r *Repository) GetMaterialType(ctx context.Context, code string) (*model.MaterialType, *errors.Error) {
 	mt := new(model.MaterialType)
 	err := r.db.QueryRowContext(ctx, GetMaterialTypeQuery, code).Scan(&mt.Code, &mt.Description, &mt.ValuationClass, &mt.CreatedAt, &mt.UpdatedAt)
@@ -644,7 +1011,11 @@ func (r *Repository) UpdateManufacturer(ctx context.Context, m model.Manufacture
 }
 
 func (r *Repository) DeleteMaterialType(ctx context.Context, code string) *errors.Error {
-	_, err := r.db.ExecContext(ctx, DeleteMaterialTypeQuery, code)
+	return deleteWithAssociations(ctx, r.db, code, DeleteMaterialTypeQuery, DeletePlantMaterialTypesByTypeQuery, DeleteMaterialTypeGroupsByTypeQuery)
+}
+
+func (r *Repository) DeleteMaterialUoM(ctx context.Context, code string) *errors.Error {
+	_, err := r.db.ExecContext(ctx, DeleteMaterialUoMQuery, code)
 	if err != nil {
 		return errors.New(errors.RunQueryFailure).Wrap(err)
 	}
@@ -652,8 +1023,36 @@ func (r *Repository) DeleteMaterialType(ctx context.Context, code string) *error
 	return nil
 }
 
-func (r *Repository) DeleteMaterialUoM(ctx context.Context, code string) *errors.Error {
-	_, err := r.db.ExecContext(ctx, DeleteMaterialUoMQuery, code)
+func (r *Repository) DeleteMaterialGroup(ctx context.Context, code string) *errors.Error {
+	return deleteWithAssociations(ctx, r.db, code, DeleteMaterialGroupQuery, DeleteMaterialTypeGroupsByGroupQuery)
+}
+
+func (r *Repository) DeletePlant(ctx context.Context, code string) *errors.Error {
+	return deleteWithAssociations(ctx, r.db, code, DeletePlantQuery, DeletePlantMaterialTypesByPlantQuery)
+}
+
+func deleteWithAssociations(ctx context.Context, db *sql.DB, code string, queries ...string) *errors.Error {
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelDefault})
+	if err != nil {
+		return errors.New(errors.StartingTransactionFailure).Wrap(err)
+	}
+	defer tx.Rollback()
+
+	for _, deleteQuery := range queries {
+		if _, err := tx.ExecContext(ctx, deleteQuery, code); err != nil {
+			return errors.New(errors.RunQueryFailure).Wrap(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.New(errors.CommittingTransactionFailure).Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteManufacturer(ctx context.Context, code string) *errors.Error {
+	_, err := r.db.ExecContext(ctx, DeleteManufacturerQuery, code)
 	if err != nil {
 		return errors.New(errors.RunQueryFailure).Wrap(err)
 	}
@@ -661,29 +1060,494 @@ func (r *Repository) DeleteMaterialUoM(ctx context.Context, code string) *errors
 	return nil
 }
 
-func (r *Repository) DeleteMaterialGroup(ctx context.Context, code string) *errors.Error {
-	_, err := r.db.ExecContext(ctx, DeleteMaterialGroupQuery, code)
+func (r *Repository) CreateAbbreviation(ctx context.Context, a model.Abbreviation) *errors.Error {
+	_, err := r.db.ExecContext(ctx, CreateAbbreviationQuery, a.Term, a.Abbreviation)
 	if err != nil {
+		if errors.HasMySQLErrCode(err, 1062) {
+			return errors.New(errors.AbbreviationAlreadyExists).Wrap(err)
+		}
 		return errors.New(errors.RunQueryFailure).Wrap(err)
 	}
 
 	return nil
 }
 
-func (r *Repository) DeletePlant(ctx context.Context, code string) *errors.Error {
-	_, err := r.db.ExecContext(ctx, DeletePlantQuery, code)
+func (r *Repository) ListAbbreviations(ctx context.Context, criteria model.ListAbbreviationsCriteria) (*model.Abbreviations, *errors.Error) {
+	query, args, err := r.buildListAbbreviationsQuery(criteria)
+	if err != nil {
+		return nil, errors.New(errors.BuildQueryFailure).Wrap(err)
+	}
+
+	a := new(model.Abbreviations)
+	err = r.db.QueryRowContext(ctx, query, args...).Scan(&a)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return a, nil
+}
+
+func (r *Repository) buildListAbbreviationsQuery(criteria model.ListAbbreviationsCriteria) (string, []any, error) {
+	param := listParam{
+		q:    strings.Builder{},
+		args: make([]any, 0, 5),
+	}
+	param.q.WriteString(ListAbbreviationQuery)
+
+	r.filterAbbreviation(criteria.FilterAbbreviation, &param)
+	if err := r.sort(criteria.Sort, &param, model.IsAvailableToSortAbbreviation); err != nil {
+		return "", nil, err
+	}
+	if err := r.paginate(criteria.Page, &param); err != nil {
+		return "", nil, err
+	}
+
+	return param.q.String(), param.args, nil
+}
+
+func (r *Repository) filterAbbreviation(filter model.FilterAbbreviation, param *listParam) {
+	whereClauses := make([]string, 0, 5)
+	whereClauses = append(whereClauses, "deleted_at = 0 ")
+
+	if len(filter.Term) != 0 {
+		whereClauses = append(whereClauses, "term LIKE ? ")
+		param.args = append(param.args, fmt.Sprintf("%%%s%%", filter.Term))
+	}
+
+	param.q.WriteString(fmt.Sprintf("WHERE %s ", strings.Join(whereClauses, "AND ")))
+}
+
+func (r *Repository) ListAllAbbreviations(ctx context.Context) ([]*model.Abbreviation, *errors.Error) {
+	rows, err := r.db.QueryContext(ctx, ListAllAbbreviationsQuery)
+	if err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	defer rows.Close()
+
+	abbreviations := make([]*model.Abbreviation, 0, 100)
+	for rows.Next() {
+		a := new(model.Abbreviation)
+		if err = rows.Scan(&a.Term, &a.Abbreviation, &a.CreatedAt, &a.UpdatedAt); err != nil {
+			return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+		}
+		abbreviations = append(abbreviations, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+	}
+
+	return abbreviations, nil
+}
+
+func (r *Repository) GetAbbreviation(ctx context.Context, term string) (*model.Abbreviation, *errors.Error) {
+	a := new(model.Abbreviation)
+	err := r.db.QueryRowContext(ctx, GetAbbreviationQuery, term).Scan(&a.Term, &a.Abbreviation, &a.CreatedAt, &a.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.New(errors.AbbreviationNotFound)
+		}
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return a, nil
+}
+
+func (r *Repository) UpdateAbbreviation(ctx context.Context, a model.Abbreviation) *errors.Error {
+	res, err := r.db.ExecContext(ctx, UpdateAbbreviationQuery, a.Abbreviation, a.Term)
 	if err != nil {
 		return errors.New(errors.RunQueryFailure).Wrap(err)
 	}
 
+	row, err := res.RowsAffected()
+	if err != nil {
+		return errors.New(errors.RowsAffectedFailure).Wrap(err)
+	}
+
+	if row < 1 {
+		return errors.New(errors.AbbreviationNotFound)
+	}
+
 	return nil
 }
 
-func (r *Repository) DeleteManufacturer(ctx context.Context, code string) *errors.Error {
-	_, err := r.db.ExecContext(ctx, DeleteManufacturerQuery, code)
+func (r *Repository) DeleteAbbreviation(ctx context.Context, term string) *errors.Error {
+	_, err := r.db.ExecContext(ctx, DeleteAbbreviationQuery, term)
+	if err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ListMaterials(ctx context.Context, criteria model.ListMaterialsCriteria) (*model.Materials, *errors.Error) {
+	query, args, err := r.buildListMaterialsQuery(criteria)
+	if err != nil {
+		return nil, errors.New(errors.BuildQueryFailure).Wrap(err)
+	}
+
+	m := new(model.Materials)
+	err = r.db.QueryRowContext(ctx, query, args...).Scan(&m)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return m, nil
+}
+
+func (r *Repository) buildListMaterialsQuery(criteria model.ListMaterialsCriteria) (string, []any, error) {
+	param := listParam{
+		q:    strings.Builder{},
+		args: make([]any, 0, 10),
+	}
+	param.q.WriteString(ListMaterialQuery)
+
+	r.filterMaterial(criteria.FilterMaterial, &param)
+	if err := r.sort(criteria.Sort, &param, model.IsAvailableToSortMaterial); err != nil {
+		return "", nil, err
+	}
+	if err := r.paginate(criteria.Page, &param); err != nil {
+		return "", nil, err
+	}
+
+	return param.q.String(), param.args, nil
+}
+
+func (r *Repository) filterMaterial(filter model.FilterMaterial, param *listParam) {
+	whereClauses := make([]string, 0, 10)
+	whereClauses = append(whereClauses, "deleted_at = 0 ", "status <> ? ")
+	param.args = append(param.args, model.Draft)
+
+	if filter.Status != 0 {
+		whereClauses = append(whereClauses, "status = ? ")
+		param.args = append(param.args, filter.Status)
+	}
+
+	if len(filter.Number) != 0 {
+		whereClauses = append(whereClauses, "number = ? ")
+		param.args = append(param.args, filter.Number)
+	}
+
+	if len(filter.PlantCode) != 0 {
+		whereClauses = append(whereClauses, "plant_code = ? ")
+		param.args = append(param.args, filter.PlantCode)
+	}
+
+	if len(filter.TypeCode) != 0 {
+		whereClauses = append(whereClauses, "type_code = ? ")
+		param.args = append(param.args, filter.TypeCode)
+	}
+
+	if len(filter.GroupCode) != 0 {
+		whereClauses = append(whereClauses, "group_code = ? ")
+		param.args = append(param.args, filter.GroupCode)
+	}
+
+	if len(filter.ManufacturerCode) != 0 {
+		whereClauses = append(whereClauses, "manufacturer_code = ? ")
+		param.args = append(param.args, filter.ManufacturerCode)
+	}
+
+	for _, term := range strings.Fields(filter.Query) {
+		whereClauses = append(whereClauses, "(short_text LIKE ? OR long_text LIKE ?) ")
+		param.args = append(param.args, fmt.Sprintf("%%%s%%", term), fmt.Sprintf("%%%s%%", term))
+	}
+
+	param.q.WriteString(fmt.Sprintf("WHERE %s ", strings.Join(whereClauses, "AND ")))
+}
+
+func (r *Repository) GetMaterial(ctx context.Context, ID model.UUID) (*model.Material, *errors.Error) {
+	m := new(model.Material)
+	err := r.db.QueryRowContext(ctx, GetMaterialQuery, ID, model.Draft).Scan(m)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.New(errors.MaterialNotFound)
+		}
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return m, nil
+}
+
+func (r *Repository) ListMaterialsByNumber(ctx context.Context, number string) ([]*model.Material, *errors.Error) {
+	rows, err := r.db.QueryContext(ctx, ListMaterialsByNumberQuery, number, model.Published)
+	if err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	defer rows.Close()
+
+	materials := make([]*model.Material, 0, 5)
+	for rows.Next() {
+		m := new(model.Material)
+		if err = rows.Scan(m); err != nil {
+			return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+		}
+		materials = append(materials, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+	}
+
+	if len(materials) == 0 {
+		return nil, errors.New(errors.MaterialNumberNotFound)
+	}
+
+	return materials, nil
+}
+
+func (r *Repository) CreatePlantMaterialType(ctx context.Context, pmt model.PlantMaterialType) *errors.Error {
+	res, err := r.db.ExecContext(ctx, CreatePlantMaterialTypeQuery, pmt.PlantCode, pmt.TypeCode, pmt.PlantCode, pmt.TypeCode)
 	if err != nil {
+		if errors.HasMySQLErrCode(err, 1062) {
+			return errors.New(errors.PlantMaterialTypeAlreadyExists).Wrap(err)
+		}
 		return errors.New(errors.RunQueryFailure).Wrap(err)
 	}
 
+	row, err := res.RowsAffected()
+	if err != nil {
+		return errors.New(errors.RowsAffectedFailure).Wrap(err)
+	}
+
+	if row < 1 {
+		return errors.New(errors.MaterialPropertiesNotFound)
+	}
+
 	return nil
 }
+
+func (r *Repository) ListPlantMaterialTypes(ctx context.Context, plantCode string) ([]*model.MaterialType, *errors.Error) {
+	rows, err := r.db.QueryContext(ctx, ListPlantMaterialTypesQuery, plantCode)
+	if err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	defer rows.Close()
+
+	mts := make([]*model.MaterialType, 0, 10)
+	for rows.Next() {
+		mt := new(model.MaterialType)
+		if err = rows.Scan(&mt.Code, &mt.Description, &mt.ValuationClass, &mt.CreatedAt, &mt.UpdatedAt); err != nil {
+			return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+		}
+		mts = append(mts, mt)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+	}
+
+	return mts, nil
+}
+
+func (r *Repository) DeletePlantMaterialType(ctx context.Context, pmt model.PlantMaterialType) *errors.Error {
+	_, err := r.db.ExecContext(ctx, DeletePlantMaterialTypeQuery, pmt.PlantCode, pmt.TypeCode)
+	if err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateMaterialTypeGroup(ctx context.Context, mtg model.MaterialTypeGroup) *errors.Error {
+	res, err := r.db.ExecContext(ctx, CreateMaterialTypeGroupQuery, mtg.TypeCode, mtg.GroupCode, mtg.TypeCode, mtg.GroupCode)
+	if err != nil {
+		if errors.HasMySQLErrCode(err, 1062) {
+			return errors.New(errors.MaterialTypeGroupAlreadyExists).Wrap(err)
+		}
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	row, err := res.RowsAffected()
+	if err != nil {
+		return errors.New(errors.RowsAffectedFailure).Wrap(err)
+	}
+
+	if row < 1 {
+		return errors.New(errors.MaterialPropertiesNotFound)
+	}
+
+	return nil
+}
+
+func (r *Repository) ListMaterialTypeGroups(ctx context.Context, typeCode string) ([]*model.MaterialGroup, *errors.Error) {
+	rows, err := r.db.QueryContext(ctx, ListMaterialTypeGroupsQuery, typeCode)
+	if err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	defer rows.Close()
+
+	mgs := make([]*model.MaterialGroup, 0, 10)
+	for rows.Next() {
+		mg := new(model.MaterialGroup)
+		if err = rows.Scan(&mg.Code, &mg.Description, &mg.CreatedAt, &mg.UpdatedAt); err != nil {
+			return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+		}
+		mgs = append(mgs, mg)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+	}
+
+	return mgs, nil
+}
+
+func (r *Repository) DeleteMaterialTypeGroup(ctx context.Context, mtg model.MaterialTypeGroup) *errors.Error {
+	_, err := r.db.ExecContext(ctx, DeleteMaterialTypeGroupQuery, mtg.TypeCode, mtg.GroupCode)
+	if err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateImportJob(ctx context.Context, job model.ImportJob, filePath string) *errors.Error {
+	_, err := r.db.ExecContext(ctx, CreateImportJobQuery, job.ID, job.Entity, job.Mode, job.DryRun, job.Status, job.Filename, filePath, job.CreatedBy)
+	if err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetImportJob(ctx context.Context, ID model.UUID) (*model.ImportJob, *errors.Error) {
+	job := new(model.ImportJob)
+	err := r.db.QueryRowContext(ctx, GetImportJobQuery, ID).Scan(
+		&job.ID,
+		&job.Entity,
+		&job.Mode,
+		&job.DryRun,
+		&job.Status,
+		&job.Filename,
+		&job.ProcessedRows,
+		&job.Report,
+		&job.ErrorCode,
+		&job.CreatedBy,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.New(errors.ImportJobNotFound)
+		}
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return job, nil
+}
+
+func (r *Repository) GetImportJobFile(ctx context.Context, ID model.UUID) (string, *errors.Error) {
+	var filePath string
+	err := r.db.QueryRowContext(ctx, GetImportJobFileQuery, ID).Scan(&filePath)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", errors.New(errors.ImportJobNotFound)
+		}
+		return "", errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return filePath, nil
+}
+
+func (r *Repository) StartImportJob(ctx context.Context, ID model.UUID) (bool, *errors.Error) {
+	res, err := r.db.ExecContext(ctx, StartImportJobQuery, model.ImportJobProcessing, ID, model.ImportJobQueued, model.ImportJobProcessing)
+	if err != nil {
+		return false, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	row, err := res.RowsAffected()
+	if err != nil {
+		return false, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return row > 0, nil
+}
+
+func (r *Repository) UpdateImportJobProgress(ctx context.Context, ID model.UUID, processedRows int) *errors.Error {
+	if _, err := r.db.ExecContext(ctx, UpdateImportJobProgressQuery, processedRows, ID); err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) FinishImportJob(ctx context.Context, job model.ImportJob, removeFile bool) *errors.Error {
+	var report []byte
+	if job.Report != nil {
+		b, err := json.Marshal(job.Report)
+		if err != nil {
+			return errors.New(errors.JSONEncodeFailure).Wrap(err)
+		}
+		report = b
+	}
+
+	_, err := r.db.ExecContext(ctx, FinishImportJobQuery, job.Status, job.ProcessedRows, report, job.ErrorCode, removeFile, job.ID)
+	if err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) CommitImportJob(ctx context.Context, ID model.UUID) *errors.Error {
+	res, err := r.db.ExecContext(ctx, CommitImportJobQuery, model.ImportJobQueued, ID, model.ImportJobPreviewed)
+	if err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	row, err := res.RowsAffected()
+	if err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	if row == 0 {
+		return errors.New(errors.ImportJobIsNotPreviewed)
+	}
+
+	return nil
+}
+
+func (r *Repository) ExpireImportJobs(ctx context.Context, updatedBefore int64) ([]string, *errors.Error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelDefault})
+	if err != nil {
+		return nil, errors.New(errors.StartingTransactionFailure).Wrap(err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, ListExpiredImportJobsQuery, model.ImportJobPreviewed, updatedBefore)
+	if err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	defer rows.Close()
+
+	IDs := make([]model.UUID, 0)
+	filePaths := make([]string, 0)
+	for rows.Next() {
+		var ID model.UUID
+		var filePath *string
+		if err := rows.Scan(&ID, &filePath); err != nil {
+			return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+		}
+		IDs = append(IDs, ID)
+		if filePath != nil {
+			filePaths = append(filePaths, *filePath)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	rows.Close()
+
+	for _, ID := range IDs {
+		if _, err := tx.ExecContext(ctx, ExpireImportJobQuery, model.ImportJobExpired, ID); err != nil {
+			return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.New(errors.CommittingTransactionFailure).Wrap(err)
+	}
+
+	return filePaths, nil
+}
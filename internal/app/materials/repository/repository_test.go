@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"iter"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dev-pt-bai/cataloging/internal/model"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+	"github.com/go-sql-driver/mysql"
+)
+
+// masterDataDriver mimics how MySQL counts affected rows for master data inserts and upserts
+// on a connection without clientFoundRows: 1 for an insert, 2 for an update, 0 when no column changes.
+type masterDataDriver struct {
+	descriptions map[string]string
+}
+
+func (d *masterDataDriver) Open(name string) (driver.Conn, error) {
+	return d, nil
+}
+
+func (d *masterDataDriver) Prepare(query string) (driver.Stmt, error) {
+	return &masterDataStmt{driver: d, query: query}, nil
+}
+
+func (d *masterDataDriver) Close() error {
+	return nil
+}
+
+func (d *masterDataDriver) Begin() (driver.Tx, error) {
+	return d, nil
+}
+
+func (d *masterDataDriver) Commit() error {
+	return nil
+}
+
+func (d *masterDataDriver) Rollback() error {
+	return nil
+}
+
+type masterDataStmt struct {
+	driver *masterDataDriver
+	query  string
+}
+
+func (s *masterDataStmt) Close() error {
+	return nil
+}
+
+func (s *masterDataStmt) NumInput() int {
+	return -1
+}
+
+func (s *masterDataStmt) Exec(args []driver.Value) (driver.Result, error) {
+	code, description := args[0].(string), args[1].(string)
+	current, exists := s.driver.descriptions[code]
+	if !exists {
+		s.driver.descriptions[code] = description
+		return driver.RowsAffected(1), nil
+	}
+
+	update := strings.Index(s.query, "ON DUPLICATE KEY UPDATE")
+	if update < 0 {
+		return nil, &mysql.MySQLError{Number: 1062}
+	}
+
+	s.driver.descriptions[code] = description
+	guarded := strings.Index(s.query, "updated_at = IF(description <=> VALUES(description)")
+	if current == description && guarded > update && guarded < strings.Index(s.query, "description = VALUES(description)") {
+		return driver.RowsAffected(0), nil
+	}
+
+	return driver.RowsAffected(2), nil
+}
+
+func (s *masterDataStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return nil, driver.ErrSkip
+}
+
+func TestBulkCreateManufacturerUpsertStatuses(t *testing.T) {
+	sql.Register("masterdata", &masterDataDriver{descriptions: map[string]string{"SKF": "SKF Group", "FAG": "FAG"}})
+	db, err := sql.Open("masterdata", "")
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer db.Close()
+
+	ms := []model.Manufacturer{{Code: "SKF", Description: "SKF Group"}, {Code: "FAG", Description: "Schaeffler"}, {Code: "NSK", Description: "NSK Ltd"}}
+	items := func(yield func(model.Manufacturer, *errors.Error) bool) {
+		for _, m := range ms {
+			if !yield(m, nil) {
+				return
+			}
+		}
+	}
+
+	statuses, errCreate := New(db).BulkCreateManufacturer(context.Background(), iter.Seq2[model.Manufacturer, *errors.Error](items), model.BulkUpsert, func(statuses []model.BulkImportStatus) bool { return true })
+	if errCreate != nil {
+		t.Fatalf("BulkCreateManufacturer() error = %v", errCreate)
+	}
+
+	want := []model.BulkImportStatus{model.BulkImportUnchanged, model.BulkImportUpdated, model.BulkImportCreated}
+	if !reflect.DeepEqual(want, statuses) {
+		t.Errorf("want: %v, got: %v", want, statuses)
+	}
+}
+
+func TestUpsertQueriesKeepUpdatedAtForUnchangedRows(t *testing.T) {
+	for _, query := range []string{UpsertMaterialTypeQuery, UpsertMaterialUoMQuery, UpsertMaterialGroupQuery, UpsertPlantQuery, UpsertManufacturerQuery} {
+		guard := strings.Index(query, "updated_at = IF(description <=> VALUES(description)")
+		if guard < 0 || guard > strings.Index(query, "description = VALUES(description)") {
+			t.Errorf("upsert does not keep updated_at before assigning columns: %s", query)
+		}
+	}
+
+	if !strings.Contains(UpsertMaterialTypeQuery, "val_class <=> VALUES(val_class)") {
+		t.Errorf("material type upsert ignores valuation class changes")
+	}
+}
@@ -2,11 +2,23 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
+	"iter"
+	"log/slog"
 	"mime/multipart"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
 
+	"github.com/dev-pt-bai/cataloging/configs"
 	"github.com/dev-pt-bai/cataloging/internal/model"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/async/manager"
 	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/excel"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/tabular"
 )
 
 type Repository interface {
@@ -15,7 +27,11 @@ type Repository interface {
 	CreateMaterialGroup(ctx context.Context, mg model.MaterialGroup) *errors.Error
 	CreatePlant(ctx context.Context, p model.Plant) *errors.Error
 	CreateManufacturer(ctx context.Context, m model.Manufacturer) *errors.Error
-	BulkCreateManufacturer(ctx context.Context, ms []model.Manufacturer) *errors.Error
+	BulkCreateMaterialType(ctx context.Context, mts iter.Seq2[model.MaterialType, *errors.Error], mode model.BulkImportMode, commit func(statuses []model.BulkImportStatus) bool) ([]model.BulkImportStatus, *errors.Error)
+	BulkCreateMaterialUoM(ctx context.Context, uoms iter.Seq2[model.MaterialUoM, *errors.Error], mode model.BulkImportMode, commit func(statuses []model.BulkImportStatus) bool) ([]model.BulkImportStatus, *errors.Error)
+	BulkCreateMaterialGroup(ctx context.Context, mgs iter.Seq2[model.MaterialGroup, *errors.Error], mode model.BulkImportMode, commit func(statuses []model.BulkImportStatus) bool) ([]model.BulkImportStatus, *errors.Error)
+	BulkCreatePlant(ctx context.Context, ps iter.Seq2[model.Plant, *errors.Error], mode model.BulkImportMode, commit func(statuses []model.BulkImportStatus) bool) ([]model.BulkImportStatus, *errors.Error)
+	BulkCreateManufacturer(ctx context.Context, ms iter.Seq2[model.Manufacturer, *errors.Error], mode model.BulkImportMode, commit func(statuses []model.BulkImportStatus) bool) ([]model.BulkImportStatus, *errors.Error)
 	ListMaterialTypes(ctx context.Context, criteria model.ListMaterialTypesCriteria) (*model.MaterialTypes, *errors.Error)
 	ListMaterialUoMs(ctx context.Context, criteria model.ListMaterialUoMsCriteria) (*model.MaterialUoMs, *errors.Error)
 	ListMaterialGroups(ctx context.Context, criteria model.ListMaterialGroupsCriteria) (*model.MaterialGroups, *errors.Error)
@@ -36,19 +52,95 @@ type Repository interface {
 	DeleteMaterialGroup(ctx context.Context, code string) *errors.Error
 	DeletePlant(ctx context.Context, code string) *errors.Error
 	DeleteManufacturer(ctx context.Context, code string) *errors.Error
+	ListMaterials(ctx context.Context, criteria model.ListMaterialsCriteria) (*model.Materials, *errors.Error)
+	GetMaterial(ctx context.Context, ID model.UUID) (*model.Material, *errors.Error)
+	ListMaterialsByNumber(ctx context.Context, number string) ([]*model.Material, *errors.Error)
+	CreateAbbreviation(ctx context.Context, a model.Abbreviation) *errors.Error
+	ListAbbreviations(ctx context.Context, criteria model.ListAbbreviationsCriteria) (*model.Abbreviations, *errors.Error)
+	ListAllAbbreviations(ctx context.Context) ([]*model.Abbreviation, *errors.Error)
+	ListAllMaterialTypes(ctx context.Context) ([]*model.MaterialType, *errors.Error)
+	ListAllMaterialUoMs(ctx context.Context) ([]*model.MaterialUoM, *errors.Error)
+	ListAllMaterialGroups(ctx context.Context) ([]*model.MaterialGroup, *errors.Error)
+	ListAllPlants(ctx context.Context) ([]*model.Plant, *errors.Error)
+	ListAllManufacturers(ctx context.Context) ([]*model.Manufacturer, *errors.Error)
+	GetAbbreviation(ctx context.Context, term string) (*model.Abbreviation, *errors.Error)
+	UpdateAbbreviation(ctx context.Context, a model.Abbreviation) *errors.Error
+	DeleteAbbreviation(ctx context.Context, term string) *errors.Error
+	CreatePlantMaterialType(ctx context.Context, pmt model.PlantMaterialType) *errors.Error
+	ListPlantMaterialTypes(ctx context.Context, plantCode string) ([]*model.MaterialType, *errors.Error)
+	DeletePlantMaterialType(ctx context.Context, pmt model.PlantMaterialType) *errors.Error
+	CreateMaterialTypeGroup(ctx context.Context, mtg model.MaterialTypeGroup) *errors.Error
+	ListMaterialTypeGroups(ctx context.Context, typeCode string) ([]*model.MaterialGroup, *errors.Error)
+	DeleteMaterialTypeGroup(ctx context.Context, mtg model.MaterialTypeGroup) *errors.Error
+	CreateImportJob(ctx context.Context, job model.ImportJob, filePath string) *errors.Error
+	GetImportJob(ctx context.Context, ID model.UUID) (*model.ImportJob, *errors.Error)
+	GetImportJobFile(ctx context.Context, ID model.UUID) (string, *errors.Error)
+	StartImportJob(ctx context.Context, ID model.UUID) (bool, *errors.Error)
+	UpdateImportJobProgress(ctx context.Context, ID model.UUID, processedRows int) *errors.Error
+	FinishImportJob(ctx context.Context, job model.ImportJob, removeFile bool) *errors.Error
+	CommitImportJob(ctx context.Context, ID model.UUID) *errors.Error
+	ExpireImportJobs(ctx context.Context, updatedBefore int64) ([]string, *errors.Error)
 }
 
-type ExcelParser interface {
-	Open(r io.Reader) ([][]string, *errors.Error)
+type TabularReader interface {
+	MaxFileSize() int64
+	NewRowReader(r io.Reader, filename string) (tabular.RowReader, *errors.Error)
+}
+
+type ExcelWriter interface {
+	Write(sheetName string, header []string, rows [][]string) ([]byte, *errors.Error)
+	WriteHighlighted(sheetName string, header []string, rows [][]string, highlighted []int) ([]byte, *errors.Error)
+	WriteSheets(sheets []excel.Sheet) ([]byte, *errors.Error)
+}
+
+const (
+	defaultImportRetention = 24 * time.Hour
+	progressInterval       = 500
+)
+
+type TaskManager interface {
+	Enqueue(ctx context.Context, task *manager.Task) *errors.Error
 }
 
 type Service struct {
-	repository  Repository
-	excelParser ExcelParser
+	repository        Repository
+	tabularReader     TabularReader
+	excelWriter       ExcelWriter
+	taskManager       TaskManager
+	processImportTask string
+	importRetention   time.Duration
+	importDir         string
 }
 
-func New(repository Repository, excelParser ExcelParser) *Service {
-	return &Service{repository: repository, excelParser: excelParser}
+func New(repository Repository, tabularReader TabularReader, excelWriter ExcelWriter, taskManager TaskManager, config *configs.Config) (*Service, error) {
+	if config == nil {
+		return nil, fmt.Errorf("missing config")
+	}
+
+	if len(config.App.Async.TaskTypes.ProcessImport) == 0 {
+		return nil, fmt.Errorf("missing process import task name")
+	}
+
+	s := &Service{
+		repository:        repository,
+		tabularReader:     tabularReader,
+		excelWriter:       excelWriter,
+		taskManager:       taskManager,
+		processImportTask: config.App.Async.TaskTypes.ProcessImport,
+		importRetention:   defaultImportRetention,
+		importDir:         config.App.Spreadsheet.ImportDir,
+	}
+	if config.App.Spreadsheet.ImportRetentionHours > 0 {
+		s.importRetention = time.Duration(config.App.Spreadsheet.ImportRetentionHours) * time.Hour
+	}
+	if len(s.importDir) == 0 {
+		s.importDir = filepath.Join(os.TempDir(), "cataloging-imports")
+	}
+	if err := os.MkdirAll(s.importDir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create import directory: %w", err)
+	}
+
+	return s, nil
 }
 
 func (s *Service) CreateMaterialType(ctx context.Context, mt model.MaterialType) *errors.Error {
@@ -71,46 +163,590 @@ func (s *Service) CreateManufacturer(ctx context.Context, m model.Manufacturer)
 	return s.repository.CreateManufacturer(ctx, m)
 }
 
-func (s *Service) BulkCreateManufacturers(ctx context.Context, file multipart.File) *errors.Error {
-	res, err := s.excelParser.Open(file)
+func (s *Service) BulkCreateMaterialTypes(ctx context.Context, file multipart.File, filename string, mode model.BulkImportMode) (*model.BulkImportReport, *errors.Error) {
+	return s.importFile(ctx, model.ImportMaterialTypes, file, filename, bulkImportOptions{mode: mode})
+}
+
+func (s *Service) BulkCreateMaterialUoMs(ctx context.Context, file multipart.File, filename string, mode model.BulkImportMode) (*model.BulkImportReport, *errors.Error) {
+	return s.importFile(ctx, model.ImportMaterialUoMs, file, filename, bulkImportOptions{mode: mode})
+}
+
+func (s *Service) BulkCreateMaterialGroups(ctx context.Context, file multipart.File, filename string, mode model.BulkImportMode) (*model.BulkImportReport, *errors.Error) {
+	return s.importFile(ctx, model.ImportMaterialGroups, file, filename, bulkImportOptions{mode: mode})
+}
+
+func (s *Service) BulkCreatePlants(ctx context.Context, file multipart.File, filename string, mode model.BulkImportMode) (*model.BulkImportReport, *errors.Error) {
+	return s.importFile(ctx, model.ImportPlants, file, filename, bulkImportOptions{mode: mode})
+}
+
+func (s *Service) BulkCreateManufacturers(ctx context.Context, file multipart.File, filename string, mode model.BulkImportMode) (*model.BulkImportReport, *errors.Error) {
+	return s.importFile(ctx, model.ImportManufacturers, file, filename, bulkImportOptions{mode: mode})
+}
+
+func (s *Service) CreatePlantMaterialType(ctx context.Context, pmt model.PlantMaterialType) *errors.Error {
+	return s.repository.CreatePlantMaterialType(ctx, pmt)
+}
+
+func (s *Service) ListPlantMaterialTypes(ctx context.Context, plantCode string) ([]*model.MaterialType, *errors.Error) {
+	return s.repository.ListPlantMaterialTypes(ctx, plantCode)
+}
+
+func (s *Service) DeletePlantMaterialType(ctx context.Context, pmt model.PlantMaterialType) *errors.Error {
+	return s.repository.DeletePlantMaterialType(ctx, pmt)
+}
+
+func (s *Service) CreateMaterialTypeGroup(ctx context.Context, mtg model.MaterialTypeGroup) *errors.Error {
+	return s.repository.CreateMaterialTypeGroup(ctx, mtg)
+}
+
+func (s *Service) ListMaterialTypeGroups(ctx context.Context, typeCode string) ([]*model.MaterialGroup, *errors.Error) {
+	return s.repository.ListMaterialTypeGroups(ctx, typeCode)
+}
+
+func (s *Service) DeleteMaterialTypeGroup(ctx context.Context, mtg model.MaterialTypeGroup) *errors.Error {
+	return s.repository.DeleteMaterialTypeGroup(ctx, mtg)
+}
+
+func (s *Service) CreateImportJob(ctx context.Context, file io.Reader, filename string, r model.CreateImportJobRequest, requestedBy *model.Auth) (*model.ImportJob, *errors.Error) {
+	job := r.Model(filename, requestedBy)
+	filePath, err := s.storeImportFile(job.ID, file)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.CreateImportJob(ctx, job, filePath); err != nil {
+		s.removeImportFile(filePath)
+		return nil, err
+	}
+
+	if err := s.taskManager.Enqueue(ctx, model.ProcessImportTask{JobID: job.ID}.NewTask(s.processImportTask)); err != nil {
+		s.failImportJob(ctx, job, filePath, err)
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func (s *Service) GetImportJob(ctx context.Context, ID model.UUID) (*model.ImportJob, *errors.Error) {
+	return s.repository.GetImportJob(ctx, ID)
+}
+
+func (s *Service) CommitImportJob(ctx context.Context, ID model.UUID) (*model.ImportJob, *errors.Error) {
+	job, err := s.repository.GetImportJob(ctx, ID)
 	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.CommitImportJob(ctx, ID); err != nil {
+		return nil, err
+	}
+
+	job.DryRun = false
+	job.Status = model.ImportJobQueued
+
+	if err := s.taskManager.Enqueue(ctx, model.ProcessImportTask{JobID: ID}.NewTask(s.processImportTask)); err != nil {
+		filePath, errFile := s.repository.GetImportJobFile(ctx, ID)
+		if errFile != nil {
+			slog.ErrorContext(ctx, errFile.Error(), slog.String("jobID", ID.String()))
+		}
+		s.failImportJob(ctx, *job, filePath, err)
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (s *Service) ProcessImportJob(data json.RawMessage) error {
+	task := new(model.ProcessImportTask)
+	if err := json.Unmarshal(data, task); err != nil {
+		slog.Error("materials.Service.ProcessImportJob: failed to parse data", slog.String("cause", err.Error()))
+		return nil
+	}
+
+	ctx := context.Background()
+	job, err := s.repository.GetImportJob(ctx, task.JobID)
+	if err != nil {
+		if err.ContainsCodes(errors.ImportJobNotFound) {
+			slog.Error(err.Error(), slog.String("jobID", task.JobID.String()))
+			return nil
+		}
+		return err
+	}
+
+	started, err := s.repository.StartImportJob(ctx, job.ID)
+	if err != nil {
+		return err
+	}
+	if !started {
+		return nil
+	}
+
+	filePath, err := s.repository.GetImportJobFile(ctx, job.ID)
+	if err != nil && !err.ContainsCodes(errors.ImportJobNotFound) {
+		return err
+	}
+
+	var report *model.BulkImportReport
+	if err == nil {
+		report, err = s.processImportFile(ctx, job, filePath)
+	}
+
+	if err != nil {
+		if err.ContainsCodes(errors.StartingTransactionFailure, errors.PrepareStatementFailure, errors.RunQueryFailure, errors.CommittingTransactionFailure) {
+			return err
+		}
+
+		errorCode := err.Code()
+		job.Status = model.ImportJobFailed
+		job.ErrorCode = &errorCode
+		if err := s.finishImportJob(ctx, *job, filePath, true); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	job.Report = report
+	job.ProcessedRows = len(report.Rows)
+	job.Status = model.ImportJobCompleted
+	if job.DryRun {
+		job.Status = model.ImportJobPreviewed
+	}
+
+	if err := s.finishImportJob(ctx, *job, filePath, !job.DryRun); err != nil {
 		return err
 	}
 
-	m, err := s.buildBulkManufacturers(res)
+	return nil
+}
+
+func (s *Service) ExpireImportJobs() error {
+	filePaths, err := s.repository.ExpireImportJobs(context.Background(), time.Now().Add(-s.importRetention).Unix())
 	if err != nil {
 		return err
 	}
 
-	return s.repository.BulkCreateManufacturer(ctx, m)
+	for _, filePath := range filePaths {
+		s.removeImportFile(filePath)
+	}
+
+	return nil
+}
+
+func (s *Service) storeImportFile(ID model.UUID, file io.Reader) (string, *errors.Error) {
+	filePath := filepath.Join(s.importDir, ID.String())
+	f, err := os.OpenFile(filePath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return "", errors.New(errors.WriteFileFailure).Wrap(err)
+	}
+
+	size, err := io.Copy(f, io.LimitReader(file, s.tabularReader.MaxFileSize()+1))
+	if errClose := f.Close(); err == nil {
+		err = errClose
+	}
+
+	var errStore *errors.Error
+	switch {
+	case err != nil:
+		errStore = errors.New(errors.WriteFileFailure).Wrap(err)
+	case size > s.tabularReader.MaxFileSize():
+		errStore = errors.New(errors.FileOversize).Wrap(fmt.Errorf("file exceeds %d bytes", s.tabularReader.MaxFileSize()))
+	case size == 0:
+		errStore = errors.New(errors.EmptySpreadsheet)
+	}
+	if errStore != nil {
+		s.removeImportFile(filePath)
+		return "", errStore
+	}
+
+	return filePath, nil
+}
+
+func (s *Service) processImportFile(ctx context.Context, job *model.ImportJob, filePath string) (*model.BulkImportReport, *errors.Error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, errors.New(errors.ImportJobNotFound).Wrap(err)
+	}
+	defer file.Close()
+
+	return s.importFile(ctx, job.Entity, file, job.Filename, bulkImportOptions{
+		mode:   job.Mode,
+		dryRun: job.DryRun,
+		progress: func(processedRows int) {
+			if err := s.repository.UpdateImportJobProgress(ctx, job.ID, processedRows); err != nil {
+				slog.Error(err.Error(), slog.String("jobID", job.ID.String()))
+			}
+		},
+	})
+}
+
+func (s *Service) finishImportJob(ctx context.Context, job model.ImportJob, filePath string, removeFile bool) *errors.Error {
+	if err := s.repository.FinishImportJob(ctx, job, removeFile); err != nil {
+		return err
+	}
+
+	if removeFile {
+		s.removeImportFile(filePath)
+	}
+
+	return nil
+}
+
+func (s *Service) failImportJob(ctx context.Context, job model.ImportJob, filePath string, cause *errors.Error) {
+	errorCode := cause.Code()
+	job.Status = model.ImportJobFailed
+	job.ErrorCode = &errorCode
+	if err := s.finishImportJob(ctx, job, filePath, true); err != nil {
+		slog.ErrorContext(ctx, err.Error(), slog.String("jobID", job.ID.String()))
+	}
+}
+
+func (s *Service) removeImportFile(filePath string) {
+	if len(filePath) == 0 {
+		return
+	}
+
+	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		slog.Error("materials.Service: failed to remove import file", slog.String("path", filePath), slog.String("cause", err.Error()))
+	}
+}
+
+func (s *Service) importFile(ctx context.Context, entity model.ImportEntity, file io.Reader, filename string, opts bulkImportOptions) (*model.BulkImportReport, *errors.Error) {
+	switch entity {
+	case model.ImportMaterialTypes:
+		return bulkImport[model.MaterialType]{
+			columns:       model.MaterialTypeSpreadsheetColumns,
+			alreadyExists: errors.MaterialTypeAlreadyExists,
+			build: func(c *tabular.Columns, row []string) (model.MaterialType, error) {
+				r := model.UpsertMaterialTypeRequest{Code: c.Value(row, "Code"), Description: c.Value(row, "Description"), ValuationClass: c.Optional(row, "Valuation Class")}
+				if err := r.Validate(); err != nil {
+					return model.MaterialType{}, err
+				}
+				return r.Model(), nil
+			},
+			create: s.repository.BulkCreateMaterialType,
+		}.run(ctx, s.tabularReader, file, filename, opts)
+	case model.ImportMaterialUoMs:
+		return bulkImport[model.MaterialUoM]{
+			columns:       model.MasterDataSpreadsheetColumns,
+			alreadyExists: errors.MaterialUoMAlreadyExists,
+			build: func(c *tabular.Columns, row []string) (model.MaterialUoM, error) {
+				r := model.UpsertMaterialUoMRequest{Code: c.Value(row, "Code"), Description: c.Value(row, "Description")}
+				if err := r.Validate(); err != nil {
+					return model.MaterialUoM{}, err
+				}
+				return r.Model(), nil
+			},
+			create: s.repository.BulkCreateMaterialUoM,
+		}.run(ctx, s.tabularReader, file, filename, opts)
+	case model.ImportMaterialGroups:
+		return bulkImport[model.MaterialGroup]{
+			columns:       model.MasterDataSpreadsheetColumns,
+			alreadyExists: errors.MaterialGroupAlreadyExists,
+			build: func(c *tabular.Columns, row []string) (model.MaterialGroup, error) {
+				r := model.UpsertMaterialGroupRequest{Code: c.Value(row, "Code"), Description: c.Value(row, "Description")}
+				if err := r.Validate(); err != nil {
+					return model.MaterialGroup{}, err
+				}
+				return r.Model(), nil
+			},
+			create: s.repository.BulkCreateMaterialGroup,
+		}.run(ctx, s.tabularReader, file, filename, opts)
+	case model.ImportPlants:
+		return bulkImport[model.Plant]{
+			columns:       model.MasterDataSpreadsheetColumns,
+			alreadyExists: errors.PlantAlreadyExists,
+			build: func(c *tabular.Columns, row []string) (model.Plant, error) {
+				r := model.UpsertPlantRequest{Code: c.Value(row, "Code"), Description: c.Value(row, "Description")}
+				if err := r.Validate(); err != nil {
+					return model.Plant{}, err
+				}
+				return r.Model(), nil
+			},
+			create: s.repository.BulkCreatePlant,
+		}.run(ctx, s.tabularReader, file, filename, opts)
+	case model.ImportManufacturers:
+		return bulkImport[model.Manufacturer]{
+			columns:       model.MasterDataSpreadsheetColumns,
+			alreadyExists: errors.ManufacturerAlreadyExists,
+			build: func(c *tabular.Columns, row []string) (model.Manufacturer, error) {
+				r := model.UpsertManufacturerRequest{Code: c.Value(row, "Code"), Description: c.Value(row, "Description")}
+				if err := r.Validate(); err != nil {
+					return model.Manufacturer{}, err
+				}
+				return r.Model(), nil
+			},
+			create: s.repository.BulkCreateManufacturer,
+		}.run(ctx, s.tabularReader, file, filename, opts)
+	default:
+		return nil, errors.New(errors.JSONValidationFailure).Wrap(fmt.Errorf("unknown import entity: %s", entity))
+	}
 }
 
-func (s *Service) buildBulkManufacturers(src [][]string) ([]model.Manufacturer, *errors.Error) {
-	if len(src) <= 1 {
+func (s *Service) ExportBulkImportReport(report *model.BulkImportReport) ([]byte, *errors.Error) {
+	rows, failed := report.SpreadsheetRows()
+
+	return s.excelWriter.WriteHighlighted("Import Report", model.BulkImportReportColumns, rows, failed)
+}
+
+type bulkImport[T any] struct {
+	columns       []string
+	alreadyExists errors.ErrorCode
+	build         func(c *tabular.Columns, row []string) (T, error)
+	create        func(ctx context.Context, items iter.Seq2[T, *errors.Error], mode model.BulkImportMode, commit func(statuses []model.BulkImportStatus) bool) ([]model.BulkImportStatus, *errors.Error)
+}
+
+type bulkImportOptions struct {
+	mode     model.BulkImportMode
+	dryRun   bool
+	progress func(processedRows int)
+}
+
+func (b bulkImport[T]) run(ctx context.Context, reader TabularReader, file io.Reader, filename string, opts bulkImportOptions) (*model.BulkImportReport, *errors.Error) {
+	rr, err := reader.NewRowReader(file, filename)
+	if err != nil {
+		return nil, err
+	}
+	defer rr.Close()
+
+	if !rr.Next() {
+		if err := rr.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New(errors.EmptySpreadsheet)
+	}
+
+	columns, err := tabular.NewColumns(rr.Row(), b.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	report := model.NewBulkImportReport(opts.mode)
+	pending := make([]model.BulkImportRow, 0, 100)
+	items := func(yield func(T, *errors.Error) bool) {
+		processed := 0
+		for rr.Next() {
+			processed++
+			if opts.progress != nil && processed%progressInterval == 0 {
+				opts.progress(processed)
+			}
+
+			row := rr.Row()
+			if tabular.IsBlank(row) {
+				continue
+			}
+
+			result := model.BulkImportRow{Row: rr.RowNumber(), Code: columns.Value(row, "Code")}
+			item, err := b.build(columns, row)
+			if err != nil {
+				result.Status = model.BulkImportFailed
+				result.Errors = bulkImportErrors(err)
+				report.Add(result)
+				continue
+			}
+
+			pending = append(pending, result)
+			if !yield(item, nil) {
+				return
+			}
+		}
+
+		if err := rr.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+
+	rollback := false
+	statuses, err := b.create(ctx, items, opts.mode, func(statuses []model.BulkImportStatus) bool {
+		rollback = opts.mode == model.BulkInsert && (report.Failed > 0 || slices.Contains(statuses, model.BulkImportFailed))
+		return !rollback && !opts.dryRun
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pending) == 0 && report.Failed == 0 {
 		return nil, errors.New(errors.EmptySpreadsheet)
 	}
 
-	manufacturersFieldIsDefined := map[string]bool{
-		"Code":        false,
-		"Description": false,
+	for i := range pending {
+		pending[i].Status = statuses[i]
+		switch {
+		case statuses[i] == model.BulkImportFailed:
+			pending[i].Errors = []model.BulkImportError{{Column: "Code", ErrorCode: b.alreadyExists.String(), Message: "code already exists"}}
+		case rollback:
+			pending[i].Status = model.BulkImportRolledBack
+		}
+		report.Add(pending[i])
+	}
+
+	slices.SortStableFunc(report.Rows, func(a, b model.BulkImportRow) int {
+		return a.Row - b.Row
+	})
+
+	return report, nil
+}
+
+func bulkImportErrors(err error) []model.BulkImportError {
+	fieldErrors, ok := err.(model.FieldErrors)
+	if !ok {
+		return []model.BulkImportError{{ErrorCode: errors.InvalidSpreadsheetRow.String(), Message: err.Error()}}
+	}
+
+	result := make([]model.BulkImportError, len(fieldErrors))
+	for i := range fieldErrors {
+		result[i] = model.BulkImportError{
+			Column:    model.MasterDataSpreadsheetColumnOfField[fieldErrors[i].Field],
+			ErrorCode: errors.InvalidSpreadsheetRow.String(),
+			Message:   fieldErrors[i].Message,
+		}
+	}
+
+	return result
+}
+
+func (s *Service) ExportMaterialTypes(ctx context.Context) ([]byte, *errors.Error) {
+	sheet, err := s.materialTypesSheet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.excelWriter.Write(sheet.Name, sheet.Header, sheet.Rows)
+}
+
+func (s *Service) ExportMaterialUoMs(ctx context.Context) ([]byte, *errors.Error) {
+	sheet, err := s.materialUoMsSheet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.excelWriter.Write(sheet.Name, sheet.Header, sheet.Rows)
+}
+
+func (s *Service) ExportMaterialGroups(ctx context.Context) ([]byte, *errors.Error) {
+	sheet, err := s.materialGroupsSheet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.excelWriter.Write(sheet.Name, sheet.Header, sheet.Rows)
+}
+
+func (s *Service) ExportPlants(ctx context.Context) ([]byte, *errors.Error) {
+	sheet, err := s.plantsSheet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.excelWriter.Write(sheet.Name, sheet.Header, sheet.Rows)
+}
+
+func (s *Service) ExportManufacturers(ctx context.Context) ([]byte, *errors.Error) {
+	sheet, err := s.manufacturersSheet(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	for i := range src[0] {
-		if _, exists := manufacturersFieldIsDefined[src[0][i]]; !exists {
-			return nil, errors.New(errors.UnknownField)
+	return s.excelWriter.Write(sheet.Name, sheet.Header, sheet.Rows)
+}
+
+func (s *Service) ExportRequestTemplate(ctx context.Context) ([]byte, *errors.Error) {
+	sheets := []excel.Sheet{{
+		Name:   "Materials",
+		Header: model.MaterialSpreadsheetColumns,
+		Lists: []excel.List{
+			{Column: "Plant", Source: "Plants"},
+			{Column: "Type", Source: "Material Types"},
+			{Column: "UoM", Source: "Material UoMs"},
+			{Column: "Group", Source: "Material Groups"},
+			{Column: "Manufacturer", Source: "Manufacturers"},
+		},
+	}}
+
+	for _, build := range []func(ctx context.Context) (excel.Sheet, *errors.Error){s.plantsSheet, s.materialTypesSheet, s.materialUoMsSheet, s.materialGroupsSheet, s.manufacturersSheet} {
+		sheet, err := build(ctx)
+		if err != nil {
+			return nil, err
 		}
-		if manufacturersFieldIsDefined[src[0][i]] {
-			return nil, errors.New(errors.DuplicateSpreadsheetColumn)
+		sheet.Hidden = true
+		sheets = append(sheets, sheet)
+	}
+
+	return s.excelWriter.WriteSheets(sheets)
+}
+
+func (s *Service) materialTypesSheet(ctx context.Context) (excel.Sheet, *errors.Error) {
+	mts, err := s.repository.ListAllMaterialTypes(ctx)
+	if err != nil {
+		return excel.Sheet{}, err
+	}
+
+	rows := make([][]string, 0, len(mts))
+	for i := range mts {
+		valuationClass := ""
+		if mts[i].ValuationClass != nil {
+			valuationClass = *mts[i].ValuationClass
 		}
-		manufacturersFieldIsDefined[src[0][i]] = true
+		rows = append(rows, []string{mts[i].Code, mts[i].Description, valuationClass})
+	}
+
+	return excel.Sheet{Name: "Material Types", Header: model.MaterialTypeSpreadsheetColumns, Rows: rows}, nil
+}
+
+func (s *Service) materialUoMsSheet(ctx context.Context) (excel.Sheet, *errors.Error) {
+	uoms, err := s.repository.ListAllMaterialUoMs(ctx)
+	if err != nil {
+		return excel.Sheet{}, err
+	}
+
+	rows := make([][]string, 0, len(uoms))
+	for i := range uoms {
+		rows = append(rows, []string{uoms[i].Code, uoms[i].Description})
+	}
+
+	return excel.Sheet{Name: "Material UoMs", Header: model.MasterDataSpreadsheetColumns, Rows: rows}, nil
+}
+
+func (s *Service) materialGroupsSheet(ctx context.Context) (excel.Sheet, *errors.Error) {
+	mgs, err := s.repository.ListAllMaterialGroups(ctx)
+	if err != nil {
+		return excel.Sheet{}, err
 	}
 
-	m := make([]model.Manufacturer, len(src)-1)
-	for i := range src[1:] {
-		m[i] = model.Manufacturer{Code: src[i+1][0], Description: src[i+1][1]}
+	rows := make([][]string, 0, len(mgs))
+	for i := range mgs {
+		rows = append(rows, []string{mgs[i].Code, mgs[i].Description})
 	}
 
-	return m, nil
+	return excel.Sheet{Name: "Material Groups", Header: model.MasterDataSpreadsheetColumns, Rows: rows}, nil
+}
+
+func (s *Service) plantsSheet(ctx context.Context) (excel.Sheet, *errors.Error) {
+	ps, err := s.repository.ListAllPlants(ctx)
+	if err != nil {
+		return excel.Sheet{}, err
+	}
+
+	rows := make([][]string, 0, len(ps))
+	for i := range ps {
+		rows = append(rows, []string{ps[i].Code, ps[i].Description})
+	}
+
+	return excel.Sheet{Name: "Plants", Header: model.MasterDataSpreadsheetColumns, Rows: rows}, nil
+}
+
+func (s *Service) manufacturersSheet(ctx context.Context) (excel.Sheet, *errors.Error) {
+	ms, err := s.repository.ListAllManufacturers(ctx)
+	if err != nil {
+		return excel.Sheet{}, err
+	}
+
+	rows := make([][]string, 0, len(ms))
+	for i := range ms {
+		rows = append(rows, []string{ms[i].Code, ms[i].Description})
+	}
+
+	return excel.Sheet{Name: "Manufacturers", Header: model.MasterDataSpreadsheetColumns, Rows: rows}, nil
 }
 
 func (s *Service) ListMaterialTypes(ctx context.Context, criteria model.ListMaterialTypesCriteria) (*model.MaterialTypes, *errors.Error) {
@@ -192,3 +828,52 @@ func (s *Service) DeletePlant(ctx context.Context, code string) *errors.Error {
 func (s *Service) DeleteManufacturer(ctx context.Context, code string) *errors.Error {
 	return s.repository.DeleteManufacturer(ctx, code)
 }
+
+func (s *Service) ListMaterials(ctx context.Context, criteria model.ListMaterialsCriteria) (*model.Materials, *errors.Error) {
+	return s.repository.ListMaterials(ctx, criteria)
+}
+
+func (s *Service) GetMaterial(ctx context.Context, ID model.UUID) (*model.Material, *errors.Error) {
+	return s.repository.GetMaterial(ctx, ID)
+}
+
+func (s *Service) ListMaterialsByNumber(ctx context.Context, number string) ([]*model.Material, *errors.Error) {
+	return s.repository.ListMaterialsByNumber(ctx, number)
+}
+
+func (s *Service) CreateAbbreviation(ctx context.Context, a model.Abbreviation) *errors.Error {
+	return s.repository.CreateAbbreviation(ctx, a)
+}
+
+func (s *Service) ListAbbreviations(ctx context.Context, criteria model.ListAbbreviationsCriteria) (*model.Abbreviations, *errors.Error) {
+	return s.repository.ListAbbreviations(ctx, criteria)
+}
+
+func (s *Service) GetAbbreviation(ctx context.Context, term string) (*model.Abbreviation, *errors.Error) {
+	return s.repository.GetAbbreviation(ctx, model.NormalizeAbbreviationTerm(term))
+}
+
+func (s *Service) UpdateAbbreviation(ctx context.Context, a model.Abbreviation) *errors.Error {
+	return s.repository.UpdateAbbreviation(ctx, a)
+}
+
+func (s *Service) DeleteAbbreviation(ctx context.Context, term string) *errors.Error {
+	return s.repository.DeleteAbbreviation(ctx, model.NormalizeAbbreviationTerm(term))
+}
+
+func (s *Service) PreviewShortText(ctx context.Context, longText string, shortText *string) (*model.ShortTextPreview, *errors.Error) {
+	abbreviations, err := s.repository.ListAllAbbreviations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &model.ShortTextPreview{
+		Suggestions: model.NewShortTextGenerator(abbreviations).Suggest(longText),
+		Violations:  []string{},
+	}
+	if shortText != nil {
+		preview.Violations = model.ShortTextViolations(*shortText)
+	}
+
+	return preview, nil
+}
@@ -0,0 +1,326 @@
+package service
+
+import (
+	"context"
+	"iter"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dev-pt-bai/cataloging/configs"
+	"github.com/dev-pt-bai/cataloging/internal/model"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/async/manager"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/tabular"
+)
+
+func newManufacturerImport(create func(ctx context.Context, items iter.Seq2[model.Manufacturer, *errors.Error], mode model.BulkImportMode, commit func(statuses []model.BulkImportStatus) bool) ([]model.BulkImportStatus, *errors.Error)) bulkImport[model.Manufacturer] {
+	return bulkImport[model.Manufacturer]{
+		columns:       model.MasterDataSpreadsheetColumns,
+		alreadyExists: errors.ManufacturerAlreadyExists,
+		build: func(c *tabular.Columns, row []string) (model.Manufacturer, error) {
+			r := model.UpsertManufacturerRequest{Code: c.Value(row, "Code"), Description: c.Value(row, "Description")}
+			if err := r.Validate(); err != nil {
+				return model.Manufacturer{}, err
+			}
+			return r.Model(), nil
+		},
+		create: create,
+	}
+}
+
+func TestBulkImportRunStreamsRows(t *testing.T) {
+	config := new(configs.Config)
+	config.App.Spreadsheet.MaxRows = 4
+	reader, errReader := tabular.NewReader(config)
+	if errReader != nil {
+		t.Fatalf("NewReader() error = %v", errReader)
+	}
+
+	received := make([]string, 0, 3)
+	committed := false
+	b := newManufacturerImport(func(ctx context.Context, items iter.Seq2[model.Manufacturer, *errors.Error], mode model.BulkImportMode, commit func(statuses []model.BulkImportStatus) bool) ([]model.BulkImportStatus, *errors.Error) {
+		received = received[:0]
+		statuses := make([]model.BulkImportStatus, 0, 3)
+		for item, err := range items {
+			if err != nil {
+				return nil, err
+			}
+			received = append(received, item.Code)
+			statuses = append(statuses, model.BulkImportCreated)
+		}
+		committed = commit(statuses)
+		return statuses, nil
+	})
+
+	src := "Code,Description\nSKF,SKF Group\n,Missing code\nFAG,Schaeffler\n"
+	report, err := b.run(context.Background(), reader, strings.NewReader(src), "manufacturers.csv", bulkImportOptions{mode: model.BulkSkipExisting})
+	if err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+
+	if strings.Join(received, ",") != "SKF,FAG" {
+		t.Errorf("want: SKF,FAG, got: %v", received)
+	}
+
+	if !committed || report.Created != 2 || report.Failed != 1 || len(report.Rows) != 3 || report.Rows[1].Row != 3 {
+		t.Errorf("want: 2 created and row 3 failed, got: %+v", report)
+	}
+
+	report, err = b.run(context.Background(), reader, strings.NewReader(src), "manufacturers.csv", bulkImportOptions{mode: model.BulkInsert})
+	if err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+
+	if committed || report.Created != 0 || report.RolledBack != 2 || report.Failed != 1 {
+		t.Errorf("want: insert rolled back, got: %+v", report)
+	}
+
+	_, err = b.run(context.Background(), reader, strings.NewReader("Code,Description\nSKF,SKF Group\nFAG,Schaeffler\nNSK,NSK Ltd\nNTN,NTN Corp\n"), "manufacturers.csv", bulkImportOptions{mode: model.BulkInsert})
+	if !err.ContainsCodes(errors.TooManyRows) {
+		t.Errorf("want: %v, got: %v", errors.TooManyRows, err)
+	}
+}
+
+type fakeImportRepository struct {
+	Repository
+	jobs          map[model.UUID]model.ImportJob
+	files         map[model.UUID]string
+	manufacturers []string
+	failures      int
+}
+
+func newFakeImportRepository() *fakeImportRepository {
+	return &fakeImportRepository{jobs: make(map[model.UUID]model.ImportJob), files: make(map[model.UUID]string)}
+}
+
+func (r *fakeImportRepository) CreateImportJob(ctx context.Context, job model.ImportJob, filePath string) *errors.Error {
+	job.UpdatedAt = time.Now().Unix()
+	r.jobs[job.ID] = job
+	r.files[job.ID] = filePath
+	return nil
+}
+
+func (r *fakeImportRepository) GetImportJob(ctx context.Context, ID model.UUID) (*model.ImportJob, *errors.Error) {
+	job, ok := r.jobs[ID]
+	if !ok {
+		return nil, errors.New(errors.ImportJobNotFound)
+	}
+	return &job, nil
+}
+
+func (r *fakeImportRepository) GetImportJobFile(ctx context.Context, ID model.UUID) (string, *errors.Error) {
+	filePath, ok := r.files[ID]
+	if !ok {
+		return "", errors.New(errors.ImportJobNotFound)
+	}
+	return filePath, nil
+}
+
+func (r *fakeImportRepository) StartImportJob(ctx context.Context, ID model.UUID) (bool, *errors.Error) {
+	job := r.jobs[ID]
+	if job.Status != model.ImportJobQueued && job.Status != model.ImportJobProcessing {
+		return false, nil
+	}
+	job.Status = model.ImportJobProcessing
+	r.jobs[ID] = job
+	return true, nil
+}
+
+func (r *fakeImportRepository) UpdateImportJobProgress(ctx context.Context, ID model.UUID, processedRows int) *errors.Error {
+	return nil
+}
+
+func (r *fakeImportRepository) FinishImportJob(ctx context.Context, job model.ImportJob, removeFile bool) *errors.Error {
+	job.UpdatedAt = time.Now().Unix()
+	r.jobs[job.ID] = job
+	if removeFile {
+		delete(r.files, job.ID)
+	}
+	return nil
+}
+
+func (r *fakeImportRepository) CommitImportJob(ctx context.Context, ID model.UUID) *errors.Error {
+	job := r.jobs[ID]
+	if _, ok := r.files[ID]; job.Status != model.ImportJobPreviewed || !ok {
+		return errors.New(errors.ImportJobIsNotPreviewed)
+	}
+	job.Status = model.ImportJobQueued
+	job.DryRun = false
+	r.jobs[ID] = job
+	return nil
+}
+
+func (r *fakeImportRepository) ExpireImportJobs(ctx context.Context, updatedBefore int64) ([]string, *errors.Error) {
+	filePaths := make([]string, 0)
+	for ID, job := range r.jobs {
+		if job.Status != model.ImportJobPreviewed || job.UpdatedAt >= updatedBefore {
+			continue
+		}
+		job.Status = model.ImportJobExpired
+		r.jobs[ID] = job
+		filePaths = append(filePaths, r.files[ID])
+		delete(r.files, ID)
+	}
+	return filePaths, nil
+}
+
+func (r *fakeImportRepository) BulkCreateManufacturer(ctx context.Context, ms iter.Seq2[model.Manufacturer, *errors.Error], mode model.BulkImportMode, commit func(statuses []model.BulkImportStatus) bool) ([]model.BulkImportStatus, *errors.Error) {
+	if r.failures > 0 {
+		r.failures--
+		return nil, errors.New(errors.RunQueryFailure)
+	}
+
+	codes := make([]string, 0)
+	statuses := make([]model.BulkImportStatus, 0)
+	for m, err := range ms {
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, m.Code)
+		statuses = append(statuses, model.BulkImportCreated)
+	}
+
+	if commit(statuses) {
+		r.manufacturers = append(r.manufacturers, codes...)
+	}
+
+	return statuses, nil
+}
+
+type fakeTaskManager struct {
+	tasks []*manager.Task
+	err   *errors.Error
+}
+
+func (tm *fakeTaskManager) Enqueue(ctx context.Context, task *manager.Task) *errors.Error {
+	if tm.err != nil {
+		return tm.err
+	}
+	tm.tasks = append(tm.tasks, task)
+	return nil
+}
+
+func newImportService(t *testing.T, repository Repository, taskManager TaskManager) *Service {
+	config := new(configs.Config)
+	config.App.Async.TaskTypes.ProcessImport = "processImport"
+	config.App.Spreadsheet.ImportDir = t.TempDir()
+	reader, err := tabular.NewReader(config)
+	if err != nil {
+		t.Fatalf("NewReader() error = %v", err)
+	}
+
+	s, err := New(repository, reader, nil, taskManager, config)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	return s
+}
+
+func TestNewRequiresProcessImportTask(t *testing.T) {
+	if _, err := New(nil, nil, nil, nil, new(configs.Config)); err == nil {
+		t.Errorf("want: error, got: %v", err)
+	}
+}
+
+func TestImportJobLifecycle(t *testing.T) {
+	repository := newFakeImportRepository()
+	taskManager := new(fakeTaskManager)
+	s := newImportService(t, repository, taskManager)
+
+	job, err := s.CreateImportJob(context.Background(), strings.NewReader("Code,Description\nSKF,SKF Group\n"), "manufacturers.csv", model.CreateImportJobRequest{Entity: model.ImportManufacturers, Mode: model.BulkInsert, DryRun: true}, &model.Auth{UserID: "admin"})
+	if err != nil {
+		t.Fatalf("CreateImportJob() error = %v", err)
+	}
+	filePath := repository.files[job.ID]
+	if _, err := os.Stat(filePath); err != nil {
+		t.Fatalf("want: spooled file, got: %v", err)
+	}
+
+	if err := s.ProcessImportJob(taskManager.tasks[0].Data); err != nil {
+		t.Fatalf("ProcessImportJob() error = %v", err)
+	}
+	if got := repository.jobs[job.ID]; got.Status != model.ImportJobPreviewed || got.Report == nil || got.Report.Created != 1 || len(repository.manufacturers) != 0 {
+		t.Errorf("want: previewed without writes, got: %+v, %v", got, repository.manufacturers)
+	}
+
+	if _, err := s.CommitImportJob(context.Background(), job.ID); err != nil {
+		t.Fatalf("CommitImportJob() error = %v", err)
+	}
+	if got := repository.jobs[job.ID]; got.Status != model.ImportJobQueued || got.DryRun || len(taskManager.tasks) != 2 {
+		t.Errorf("want: queued commit, got: %+v", got)
+	}
+
+	repository.failures = 1
+	if err := s.ProcessImportJob(taskManager.tasks[1].Data); err == nil {
+		t.Errorf("want: %v, got: %v", errors.RunQueryFailure, err)
+	}
+	if _, err := os.Stat(filePath); err != nil || repository.jobs[job.ID].Status != model.ImportJobProcessing {
+		t.Errorf("want: file kept for retry, got: %v, %v", err, repository.jobs[job.ID].Status)
+	}
+
+	if err := s.ProcessImportJob(taskManager.tasks[1].Data); err != nil {
+		t.Fatalf("ProcessImportJob() error = %v", err)
+	}
+	if got := repository.jobs[job.ID]; got.Status != model.ImportJobCompleted || strings.Join(repository.manufacturers, ",") != "SKF" {
+		t.Errorf("want: completed with SKF, got: %+v, %v", got, repository.manufacturers)
+	}
+	if _, err := os.Stat(filePath); !os.IsNotExist(err) {
+		t.Errorf("want: file removed, got: %v", err)
+	}
+}
+
+func TestCreateImportJobEnqueueFailure(t *testing.T) {
+	repository := newFakeImportRepository()
+	s := newImportService(t, repository, &fakeTaskManager{err: errors.New(errors.EnqueueTaskFailure)})
+
+	_, err := s.CreateImportJob(context.Background(), strings.NewReader("Code,Description\nSKF,SKF Group\n"), "manufacturers.csv", model.CreateImportJobRequest{Entity: model.ImportManufacturers, Mode: model.BulkInsert}, &model.Auth{UserID: "admin"})
+	if !err.ContainsCodes(errors.EnqueueTaskFailure) {
+		t.Fatalf("want: %v, got: %v", errors.EnqueueTaskFailure, err)
+	}
+
+	for _, job := range repository.jobs {
+		if job.Status != model.ImportJobFailed || job.ErrorCode == nil || *job.ErrorCode != err.Code() {
+			t.Errorf("want: failed job, got: %+v", job)
+		}
+	}
+
+	if entries, _ := os.ReadDir(s.importDir); len(entries) != 0 {
+		t.Errorf("want: no spooled files, got: %d", len(entries))
+	}
+}
+
+func TestExpireImportJobs(t *testing.T) {
+	repository := newFakeImportRepository()
+	taskManager := new(fakeTaskManager)
+	s := newImportService(t, repository, taskManager)
+
+	job, err := s.CreateImportJob(context.Background(), strings.NewReader("Code,Description\nSKF,SKF Group\n"), "manufacturers.csv", model.CreateImportJobRequest{Entity: model.ImportManufacturers, Mode: model.BulkInsert, DryRun: true}, &model.Auth{UserID: "admin"})
+	if err != nil {
+		t.Fatalf("CreateImportJob() error = %v", err)
+	}
+	filePath := repository.files[job.ID]
+	if err := s.ProcessImportJob(taskManager.tasks[0].Data); err != nil {
+		t.Fatalf("ProcessImportJob() error = %v", err)
+	}
+
+	previewed := repository.jobs[job.ID]
+	previewed.UpdatedAt = time.Now().Add(-s.importRetention - time.Minute).Unix()
+	repository.jobs[job.ID] = previewed
+
+	if err := s.ExpireImportJobs(); err != nil {
+		t.Fatalf("ExpireImportJobs() error = %v", err)
+	}
+	if got := repository.jobs[job.ID].Status; got != model.ImportJobExpired {
+		t.Errorf("want: %v, got: %v", model.ImportJobExpired, got)
+	}
+	if _, err := os.Stat(filePath); !os.IsNotExist(err) {
+		t.Errorf("want: file removed, got: %v", err)
+	}
+
+	if _, err := s.CommitImportJob(context.Background(), job.ID); !err.ContainsCodes(errors.ImportJobIsNotPreviewed) {
+		t.Errorf("want: %v, got: %v", errors.ImportJobIsNotPreviewed, err)
+	}
+}
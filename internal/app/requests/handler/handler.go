@@ -3,17 +3,46 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"log/slog"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/dev-pt-bai/cataloging/internal/app/middleware"
 	"github.com/dev-pt-bai/cataloging/internal/model"
 	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/idoc"
 )
 
+//go:generate mockgen -source=./handler.go -destination=./mock.go -package=handler
+
 type Service interface {
-	CreateRequest(ctx context.Context, r model.Request) *errors.Error
+	CreateRequest(ctx context.Context, r model.Request) ([]model.MaterialDuplicates, *errors.Error)
 	GetRequest(ctx context.Context, ID model.UUID, requestedBy *model.Auth) (*model.Request, *errors.Error)
+	ListRequests(ctx context.Context, criteria model.ListRequestsCriteria, requestedBy *model.Auth) (*model.Requests, *errors.Error)
+	UpdateRequest(ctx context.Context, r model.Request, requestedBy *model.Auth) *errors.Error
+	DeleteRequest(ctx context.Context, ID model.UUID, requestedBy *model.Auth) *errors.Error
+	GetRequestHistory(ctx context.Context, ID model.UUID, requestedBy *model.Auth) ([]model.RequestHistory, *errors.Error)
+	TransitRequest(ctx context.Context, ID model.UUID, transition model.Transition, reason *string, actor *model.Auth) *errors.Error
+	CreateComment(ctx context.Context, c model.Comment, createdBy *model.Auth) *errors.Error
+	ListComments(ctx context.Context, requestID model.UUID, materialID *model.UUID, requestedBy *model.Auth) ([]*model.Comment, *errors.Error)
+	UpdateComment(ctx context.Context, c model.Comment, updatedBy *model.Auth) *errors.Error
+	DeleteComment(ctx context.Context, requestID model.UUID, ID model.UUID, deletedBy *model.Auth) *errors.Error
+	ReviewMaterial(ctx context.Context, requestID model.UUID, review model.MaterialReview, reviewedBy *model.Auth) *errors.Error
+	ListAssignedRequests(ctx context.Context, criteria model.ListRequestsCriteria, assignee *model.Auth) (*model.Requests, *errors.Error)
+	ClaimRequest(ctx context.Context, assignee *model.Auth) (*model.Request, *errors.Error)
+	AssignRequest(ctx context.Context, ID model.UUID, assignee string, assignedBy *model.Auth) *errors.Error
+	FindDuplicates(ctx context.Context, ID model.UUID, requestedBy *model.Auth) ([]model.MaterialDuplicates, *errors.Error)
+	GetRequestDiff(ctx context.Context, ID model.UUID, requestedBy *model.Auth) ([]model.MaterialDiff, *errors.Error)
+	MarkMaterialDuplicate(ctx context.Context, requestID model.UUID, materialID model.UUID, number string, markedBy *model.Auth) *errors.Error
+	ExportIDoc(ctx context.Context, IDs []model.UUID, requestedBy *model.Auth) ([]byte, *errors.Error)
+	ExportRequest(ctx context.Context, ID model.UUID, requestedBy *model.Auth) ([]byte, *errors.Error)
+	BulkCreateRequest(ctx context.Context, file multipart.File, filename string, r model.BulkCreateRequestRequest, requestedBy *model.Auth) (*model.Request, []model.MaterialDuplicates, []model.SpreadsheetRowError, *errors.Error)
 }
 
 type Handler struct {
@@ -60,11 +89,15 @@ func (h *Handler) CreateRequest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.service.CreateRequest(r.Context(), req.Model(nil, model.Draft, auth)); err != nil {
+	request := req.Model(nil, model.Draft, auth)
+	duplicates, err := h.service.CreateRequest(r.Context(), request)
+	if err != nil {
 		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
 		switch {
-		case err.ContainsCodes(errors.UserNotFound, errors.MaterialPropertiesNotFound, errors.AssetNotFound):
+		case err.ContainsCodes(errors.UserNotFound, errors.MaterialPropertiesNotFound, errors.AssetNotFound, errors.MaterialNumberNotFound):
 			w.WriteHeader(http.StatusNotFound)
+		case err.ContainsCodes(errors.MaterialTypeIsNotAllowed, errors.MaterialGroupIsNotAllowed):
+			w.WriteHeader(http.StatusUnprocessableEntity)
 		default:
 			w.WriteHeader(http.StatusInternalServerError)
 		}
@@ -76,6 +109,94 @@ func (h *Handler) CreateRequest(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": map[string]any{
+			"id":         request.ID,
+			"duplicates": duplicates,
+		},
+	})
+}
+
+func (h *Handler) BulkCreateRequest(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if !auth.IsVerified {
+		slog.ErrorContext(r.Context(), errors.UserIsUnverified.String(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.UserIsUnverified.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.ParsingFileFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.ParsingFileFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+	defer file.Close()
+
+	req := model.BulkCreateRequestRequest{Subject: r.FormValue("subject"), IsNew: true}
+	if isNew := r.FormValue("isNew"); len(isNew) > 0 {
+		req.IsNew, err = strconv.ParseBool(isNew)
+	}
+	if err == nil {
+		err = req.Validate()
+	}
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONValidationFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONValidationFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	request, duplicates, rowErrors, errCreate := h.service.BulkCreateRequest(r.Context(), file, header.Filename, req, auth)
+	if errCreate != nil {
+		slog.ErrorContext(r.Context(), errCreate.Error(), slog.String("requestID", requestID))
+		switch {
+		case errCreate.ContainsCodes(errors.InvalidSpreadsheetRow):
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]any{
+				"errorCode": errCreate.Code(),
+				"requestID": requestID,
+				"rows":      rowErrors,
+			})
+			return
+		case errCreate.ContainsCodes(errors.ParsingFileFailure, errors.FileOversize, errors.TooManyRows, errors.EmptySpreadsheet, errors.UnknownField):
+			w.WriteHeader(http.StatusBadRequest)
+		case errCreate.ContainsCodes(errors.DuplicateSpreadsheetColumn):
+			w.WriteHeader(http.StatusConflict)
+		case errCreate.ContainsCodes(errors.UserNotFound, errors.MaterialPropertiesNotFound, errors.MaterialNumberNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errCreate.ContainsCodes(errors.MaterialTypeIsNotAllowed, errors.MaterialGroupIsNotAllowed):
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errCreate.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": map[string]any{
+			"id":         request.ID,
+			"duplicates": duplicates,
+		},
+	})
 }
 
 func (h *Handler) GetRequest(w http.ResponseWriter, r *http.Request) {
@@ -116,3 +237,1079 @@ func (h *Handler) GetRequest(w http.ResponseWriter, r *http.Request) {
 		"data": req,
 	})
 }
+
+func (h *Handler) GetRequestHistory(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	reqID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedRequestID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedRequestID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	history, errGet := h.service.GetRequestHistory(r.Context(), reqID, auth)
+	if errGet != nil {
+		slog.ErrorContext(r.Context(), errGet.Error(), slog.String("requestID", requestID))
+		switch {
+		case errGet.ContainsCodes(errors.RequestNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errGet.ContainsCodes(errors.ResourceIsForbidden):
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errGet.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": history,
+	})
+}
+
+func (h *Handler) GetRequestDiff(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	reqID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedRequestID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedRequestID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	diffs, errGet := h.service.GetRequestDiff(r.Context(), reqID, auth)
+	if errGet != nil {
+		slog.ErrorContext(r.Context(), errGet.Error(), slog.String("requestID", requestID))
+		switch {
+		case errGet.ContainsCodes(errors.RequestNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errGet.ContainsCodes(errors.ResourceIsForbidden):
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errGet.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": diffs,
+	})
+}
+
+func (h *Handler) ExportRequest(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	reqID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedRequestID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedRequestID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	data, errExport := h.service.ExportRequest(r.Context(), reqID, auth)
+	if errExport != nil {
+		slog.ErrorContext(r.Context(), errExport.Error(), slog.String("requestID", requestID))
+		switch {
+		case errExport.ContainsCodes(errors.RequestNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errExport.ContainsCodes(errors.ResourceIsForbidden):
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errExport.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"request_%s.xlsx\"", reqID))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+func (h *Handler) ExportRequestIDoc(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	reqID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedRequestID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedRequestID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	h.exportIDoc(w, r, []model.UUID{reqID})
+}
+
+func (h *Handler) ExportRequestsIDoc(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	req := new(model.ExportIDocRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONDecodeFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONDecodeFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+	defer r.Body.Close()
+
+	if err := req.Validate(); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONValidationFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONValidationFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	h.exportIDoc(w, r, req.RequestIDs)
+}
+
+func (h *Handler) exportIDoc(w http.ResponseWriter, r *http.Request, IDs []model.UUID) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	data, err := h.service.ExportIDoc(r.Context(), IDs, auth)
+	if err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		switch {
+		case err.ContainsCodes(errors.RequestNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case err.ContainsCodes(errors.ResourceIsForbidden):
+			w.WriteHeader(http.StatusForbidden)
+		case err.ContainsCodes(errors.RequestIsNotApproved):
+			w.WriteHeader(http.StatusConflict)
+		case err.ContainsCodes(errors.IDocExportIsNotConfigured):
+			w.WriteHeader(http.StatusNotImplemented)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s_%s.xml\"", idoc.IDocType, time.Now().Format("20060102150405")))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+func (h *Handler) ListRequests(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	criteria, errMessages := h.buildListRequestsCriteria(r.URL.Query())
+	if len(errMessages) != 0 {
+		slog.ErrorContext(r.Context(), errMessages, slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.InvalidQueryParameter.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	requests, err := h.service.ListRequests(r.Context(), criteria, auth)
+	if err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		switch {
+		case err.ContainsCodes(errors.InvalidQueryParameter, errors.InvalidPageNumber, errors.InvalidItemNumberPerPage):
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(requests.Response(criteria.Page))
+}
+
+func (h *Handler) ListAssignedRequests(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	criteria, errMessages := h.buildListRequestsCriteria(r.URL.Query())
+	if len(errMessages) != 0 {
+		slog.ErrorContext(r.Context(), errMessages, slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.InvalidQueryParameter.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	requests, err := h.service.ListAssignedRequests(r.Context(), criteria, auth)
+	if err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		switch {
+		case err.ContainsCodes(errors.InvalidQueryParameter, errors.InvalidPageNumber, errors.InvalidItemNumberPerPage):
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(requests.Response(criteria.Page))
+}
+
+func (h *Handler) ClaimRequest(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	req, err := h.service.ClaimRequest(r.Context(), auth)
+	if err != nil {
+		slog.ErrorContext(r.Context(), err.Error(), slog.String("requestID", requestID))
+		switch {
+		case err.ContainsCodes(errors.UnassignedRequestNotFound, errors.RequestNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case err.ContainsCodes(errors.ResourceIsForbidden):
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": err.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": req,
+	})
+}
+
+func (h *Handler) AssignRequest(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	reqID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedRequestID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedRequestID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	req := new(model.AssignRequestRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONDecodeFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONDecodeFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+	defer r.Body.Close()
+
+	if err := req.Validate(); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONValidationFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONValidationFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if errAssign := h.service.AssignRequest(r.Context(), reqID, req.UserID, auth); errAssign != nil {
+		slog.ErrorContext(r.Context(), errAssign.Error(), slog.String("requestID", requestID))
+		switch {
+		case errAssign.ContainsCodes(errors.RequestNotFound, errors.UserNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errAssign.ContainsCodes(errors.ResourceIsForbidden):
+			w.WriteHeader(http.StatusForbidden)
+		case errAssign.ContainsCodes(errors.RequestIsNotReviewable):
+			w.WriteHeader(http.StatusConflict)
+		case errAssign.ContainsCodes(errors.InvalidAssignee):
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errAssign.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) buildListRequestsCriteria(q url.Values) (model.ListRequestsCriteria, string) {
+	c := model.ListRequestsCriteria{}
+	messages := make([]string, 0, 5)
+
+	c.FilterRequest.RequestedBy = q.Get("requestedBy")
+	c.FilterRequest.Subject = q.Get("subject")
+	c.FilterRequest.PlantCode = q.Get("plant")
+	c.FilterRequest.GroupCode = q.Get("group")
+
+	if statusStr := q.Get("status"); len(statusStr) != 0 {
+		status, err := strconv.Atoi(statusStr)
+		switch {
+		case err != nil:
+			messages = append(messages, fmt.Sprintf("status: %s", err.Error()))
+		case !model.Status(status).IsValid():
+			messages = append(messages, fmt.Sprintf("status: unknown value %d", status))
+		default:
+			c.FilterRequest.Status = model.Status(status)
+		}
+	}
+
+	if isNewStr := q.Get("isNew"); len(isNewStr) != 0 {
+		isNew, err := strconv.ParseBool(isNewStr)
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("isNew: %s", err.Error()))
+		} else {
+			c.FilterRequest.IsNew = model.NewFlag(isNew)
+		}
+	}
+
+	if createdFromStr := q.Get("createdFrom"); len(createdFromStr) != 0 {
+		createdFrom, err := strconv.ParseInt(createdFromStr, 10, 64)
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("createdFrom: %s", err.Error()))
+		} else {
+			c.FilterRequest.CreatedFrom = createdFrom
+		}
+	}
+
+	if createdToStr := q.Get("createdTo"); len(createdToStr) != 0 {
+		createdTo, err := strconv.ParseInt(createdToStr, 10, 64)
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("createdTo: %s", err.Error()))
+		} else {
+			c.FilterRequest.CreatedTo = createdTo
+		}
+	}
+
+	if c.FilterRequest.CreatedFrom != 0 && c.FilterRequest.CreatedTo != 0 && c.FilterRequest.CreatedFrom > c.FilterRequest.CreatedTo {
+		messages = append(messages, fmt.Sprintf("createdFrom is after createdTo: %d > %d", c.FilterRequest.CreatedFrom, c.FilterRequest.CreatedTo))
+	}
+
+	h.sort(q, &c.Sort, &messages)
+	h.paginate(q, &c.Page, &messages)
+
+	return c, strings.Join(messages, ", ")
+}
+
+func (h *Handler) sort(q url.Values, sortCriteria *model.Sort, messages *[]string) {
+	if fieldName := q.Get("sortBy"); len(fieldName) != 0 {
+		if !model.IsAvailableToSortRequest(fieldName) {
+			*messages = append(*messages, fmt.Sprintf("fieldName is not available: %s", fieldName))
+		} else {
+			sortCriteria.FieldName = fieldName
+		}
+	}
+
+	if isDecendingStr := q.Get("isDescending"); len(isDecendingStr) != 0 {
+		isDescending, err := strconv.ParseBool(q.Get("isDescending"))
+		if err != nil {
+			*messages = append(*messages, fmt.Sprintf("isDescending: %s", err.Error()))
+		} else {
+			sortCriteria.IsDescending = isDescending
+		}
+	}
+}
+
+func (h *Handler) paginate(q url.Values, page *model.Page, messages *[]string) {
+	if limitStr := q.Get("limit"); len(limitStr) != 0 {
+		limit, err := strconv.ParseInt(limitStr, 10, 0)
+		if err != nil {
+			*messages = append(*messages, fmt.Sprintf("limit: %s", err.Error()))
+		} else if limit < 1 || limit > 20 {
+			*messages = append(*messages, fmt.Sprintf("limit is out of range: %d", limit))
+		} else {
+			page.ItemPerPage = limit
+		}
+	} else {
+		page.ItemPerPage = 20
+	}
+
+	if pageStr := q.Get("page"); len(pageStr) != 0 {
+		pageInt, err := strconv.ParseInt(pageStr, 10, 0)
+		if err != nil {
+			*messages = append(*messages, fmt.Sprintf("page: %s", err.Error()))
+		} else if pageInt < 1 {
+			*messages = append(*messages, fmt.Sprintf("page is out of range: %d", pageInt))
+		} else {
+			page.Number = pageInt
+		}
+	} else {
+		page.Number = 1
+	}
+}
+
+func (h *Handler) UpdateRequest(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	reqID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedRequestID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedRequestID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	req := new(model.UpsertRequestRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONDecodeFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONDecodeFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+	defer r.Body.Close()
+
+	if err := req.Validate(); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONValidationFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONValidationFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if errUpdate := h.service.UpdateRequest(r.Context(), req.Model(&reqID, model.Draft, auth), auth); errUpdate != nil {
+		slog.ErrorContext(r.Context(), errUpdate.Error(), slog.String("requestID", requestID))
+		switch {
+		case errUpdate.ContainsCodes(errors.RequestNotFound, errors.MaterialNotFound, errors.MaterialPropertiesNotFound, errors.AssetNotFound, errors.MaterialNumberNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errUpdate.ContainsCodes(errors.MaterialTypeIsNotAllowed, errors.MaterialGroupIsNotAllowed):
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		case errUpdate.ContainsCodes(errors.ResourceIsForbidden):
+			w.WriteHeader(http.StatusForbidden)
+		case errUpdate.ContainsCodes(errors.RequestIsNotEditable):
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errUpdate.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) DeleteRequest(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	reqID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedRequestID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedRequestID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if errDelete := h.service.DeleteRequest(r.Context(), reqID, auth); errDelete != nil {
+		slog.ErrorContext(r.Context(), errDelete.Error(), slog.String("requestID", requestID))
+		switch {
+		case errDelete.ContainsCodes(errors.RequestNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errDelete.ContainsCodes(errors.ResourceIsForbidden):
+			w.WriteHeader(http.StatusForbidden)
+		case errDelete.ContainsCodes(errors.RequestIsNotEditable):
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errDelete.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) SubmitRequest(w http.ResponseWriter, r *http.Request) {
+	h.transitRequest(w, r, model.Submit)
+}
+
+func (h *Handler) ProcessRequest(w http.ResponseWriter, r *http.Request) {
+	h.transitRequest(w, r, model.Process)
+}
+
+func (h *Handler) ApproveRequest(w http.ResponseWriter, r *http.Request) {
+	h.transitRequest(w, r, model.Approve)
+}
+
+func (h *Handler) RejectRequest(w http.ResponseWriter, r *http.Request) {
+	h.transitRequest(w, r, model.Reject)
+}
+
+func (h *Handler) PublishRequest(w http.ResponseWriter, r *http.Request) {
+	h.transitRequest(w, r, model.Publish)
+}
+
+func (h *Handler) DeprecateRequest(w http.ResponseWriter, r *http.Request) {
+	h.transitRequest(w, r, model.Deprecate)
+}
+
+func (h *Handler) transitRequest(w http.ResponseWriter, r *http.Request, transition model.Transition) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if !auth.IsVerified {
+		slog.ErrorContext(r.Context(), errors.UserIsUnverified.String(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.UserIsUnverified.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	reqID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedRequestID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedRequestID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	req := new(model.TransitRequestRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil && err != io.EOF {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONDecodeFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONDecodeFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+	defer r.Body.Close()
+
+	if err := req.Validate(transition); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONValidationFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONValidationFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	if errTransit := h.service.TransitRequest(r.Context(), reqID, transition, req.Reason, auth); errTransit != nil {
+		slog.ErrorContext(r.Context(), errTransit.Error(), slog.String("requestID", requestID))
+		switch {
+		case errTransit.ContainsCodes(errors.RequestNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errTransit.ContainsCodes(errors.ResourceIsForbidden, errors.RequestTransitionIsForbidden, errors.RequestIsAssignedToOthers):
+			w.WriteHeader(http.StatusForbidden)
+		case errTransit.ContainsCodes(errors.InvalidRequestStatusTransition):
+			w.WriteHeader(http.StatusConflict)
+		case errTransit.ContainsCodes(errors.InvalidShortText, errors.MissingMaterialAttachment):
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		case errTransit.ContainsCodes(errors.PublisherIsNotConfigured):
+			w.WriteHeader(http.StatusNotImplemented)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errTransit.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	if transition == model.Submit {
+		duplicates, errFind := h.service.FindDuplicates(r.Context(), reqID, auth)
+		if errFind == nil {
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"duplicates": duplicates,
+				},
+			})
+			return
+		}
+		slog.ErrorContext(r.Context(), errFind.Error(), slog.String("requestID", requestID))
+	}
+
+	if transition == model.Publish {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) ReviewMaterial(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	reqID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedRequestID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedRequestID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	materialID, err := model.ParseUUID(r.PathValue("materialID"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedMaterialID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedMaterialID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	req := new(model.ReviewMaterialRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONDecodeFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONDecodeFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+	defer r.Body.Close()
+
+	if err := req.Validate(); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONValidationFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONValidationFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if errReview := h.service.ReviewMaterial(r.Context(), reqID, req.Model(materialID, auth), auth); errReview != nil {
+		slog.ErrorContext(r.Context(), errReview.Error(), slog.String("requestID", requestID))
+		switch {
+		case errReview.ContainsCodes(errors.RequestNotFound, errors.MaterialNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errReview.ContainsCodes(errors.ResourceIsForbidden, errors.RequestIsAssignedToOthers):
+			w.WriteHeader(http.StatusForbidden)
+		case errReview.ContainsCodes(errors.RequestIsNotReviewable):
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errReview.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) CreateComment(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	reqID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedRequestID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedRequestID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	req := new(model.UpsertCommentRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONDecodeFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONDecodeFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+	defer r.Body.Close()
+
+	if err := req.Validate(); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONValidationFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONValidationFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if errCreate := h.service.CreateComment(r.Context(), req.Model(nil, reqID, auth), auth); errCreate != nil {
+		slog.ErrorContext(r.Context(), errCreate.Error(), slog.String("requestID", requestID))
+		switch {
+		case errCreate.ContainsCodes(errors.RequestNotFound, errors.MaterialNotFound, errors.CommentNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errCreate.ContainsCodes(errors.ResourceIsForbidden):
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errCreate.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *Handler) ListComments(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	reqID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedRequestID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedRequestID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	var materialID *model.UUID
+	if materialIDStr := r.URL.Query().Get("material"); len(materialIDStr) != 0 {
+		id, err := model.ParseUUID(materialIDStr)
+		if err != nil {
+			slog.ErrorContext(r.Context(), fmt.Sprintf("material: %s", err.Error()), slog.String("requestID", requestID))
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{
+				"errorCode": errors.InvalidQueryParameter.String(),
+				"requestID": requestID,
+			})
+			return
+		}
+		materialID = &id
+	}
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	comments, errList := h.service.ListComments(r.Context(), reqID, materialID, auth)
+	if errList != nil {
+		slog.ErrorContext(r.Context(), errList.Error(), slog.String("requestID", requestID))
+		switch {
+		case errList.ContainsCodes(errors.RequestNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errList.ContainsCodes(errors.ResourceIsForbidden):
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errList.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": comments,
+	})
+}
+
+func (h *Handler) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	reqID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedRequestID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedRequestID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	commentID, err := model.ParseUUID(r.PathValue("commentID"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedCommentID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedCommentID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	req := new(model.UpsertCommentRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONDecodeFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONDecodeFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+	defer r.Body.Close()
+
+	if err := req.Validate(); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONValidationFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONValidationFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if errUpdate := h.service.UpdateComment(r.Context(), req.Model(&commentID, reqID, auth), auth); errUpdate != nil {
+		slog.ErrorContext(r.Context(), errUpdate.Error(), slog.String("requestID", requestID))
+		switch {
+		case errUpdate.ContainsCodes(errors.CommentNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errUpdate.ContainsCodes(errors.ResourceIsForbidden):
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errUpdate.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	reqID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedRequestID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedRequestID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	commentID, err := model.ParseUUID(r.PathValue("commentID"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedCommentID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedCommentID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if errDelete := h.service.DeleteComment(r.Context(), reqID, commentID, auth); errDelete != nil {
+		slog.ErrorContext(r.Context(), errDelete.Error(), slog.String("requestID", requestID))
+		switch {
+		case errDelete.ContainsCodes(errors.CommentNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errDelete.ContainsCodes(errors.ResourceIsForbidden):
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errDelete.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) FindDuplicates(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	reqID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedRequestID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedRequestID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	duplicates, errFind := h.service.FindDuplicates(r.Context(), reqID, auth)
+	if errFind != nil {
+		slog.ErrorContext(r.Context(), errFind.Error(), slog.String("requestID", requestID))
+		switch {
+		case errFind.ContainsCodes(errors.RequestNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errFind.ContainsCodes(errors.ResourceIsForbidden):
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errFind.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"data": duplicates,
+	})
+}
+
+func (h *Handler) MarkMaterialDuplicate(w http.ResponseWriter, r *http.Request) {
+	requestID, _ := r.Context().Value(middleware.RequestIDKey).(string)
+
+	reqID, err := model.ParseUUID(r.PathValue("id"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedRequestID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedRequestID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	materialID, err := model.ParseUUID(r.PathValue("materialID"))
+	if err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.MalformedMaterialID).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.MalformedMaterialID.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	req := new(model.MarkDuplicateRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONDecodeFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONDecodeFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+	defer r.Body.Close()
+
+	if err := req.Validate(); err != nil {
+		slog.ErrorContext(r.Context(), errors.New(errors.JSONValidationFailure).Wrap(err).Error(), slog.String("requestID", requestID))
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errors.JSONValidationFailure.String(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	auth, _ := r.Context().Value(middleware.AuthKey).(*model.Auth)
+	if errMark := h.service.MarkMaterialDuplicate(r.Context(), reqID, materialID, req.MaterialNumber, auth); errMark != nil {
+		slog.ErrorContext(r.Context(), errMark.Error(), slog.String("requestID", requestID))
+		switch {
+		case errMark.ContainsCodes(errors.RequestNotFound, errors.MaterialNotFound, errors.MaterialNumberNotFound):
+			w.WriteHeader(http.StatusNotFound)
+		case errMark.ContainsCodes(errors.ResourceIsForbidden, errors.RequestIsAssignedToOthers):
+			w.WriteHeader(http.StatusForbidden)
+		case errMark.ContainsCodes(errors.RequestIsNotReviewable):
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"errorCode": errMark.Code(),
+			"requestID": requestID,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
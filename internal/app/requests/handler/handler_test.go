@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dev-pt-bai/cataloging/internal/app/middleware"
+	"github.com/dev-pt-bai/cataloging/internal/model"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+	"github.com/golang/mock/gomock"
+)
+
+func TestListRequests(t *testing.T) {
+	service := NewMockService(gomock.NewController(t))
+	handler := New(service)
+
+	requestID := "dummy-request-id"
+	auth := &model.Auth{UserID: "dummy-cataloger", Role: model.Cataloger}
+	ctx := context.WithValue(context.WithValue(context.Background(), middleware.RequestIDKey, requestID), middleware.AuthKey, auth)
+
+	type response struct {
+		ErrorCode string `json:"errorCode"`
+		RequestID string `json:"requestID"`
+	}
+
+	type result struct {
+		code     int
+		response *response
+	}
+
+	tests := []struct {
+		name     string
+		query    url.Values
+		callFunc func()
+		want     result
+	}{
+		{
+			name:  "non-numeric status",
+			query: url.Values{"status": []string{"draft"}},
+			want: result{
+				code:     http.StatusBadRequest,
+				response: &response{ErrorCode: errors.InvalidQueryParameter.String(), RequestID: requestID},
+			},
+		},
+		{
+			name:  "status below range",
+			query: url.Values{"status": []string{"0"}},
+			want: result{
+				code:     http.StatusBadRequest,
+				response: &response{ErrorCode: errors.InvalidQueryParameter.String(), RequestID: requestID},
+			},
+		},
+		{
+			name:  "status above range",
+			query: url.Values{"status": []string{"99"}},
+			want: result{
+				code:     http.StatusBadRequest,
+				response: &response{ErrorCode: errors.InvalidQueryParameter.String(), RequestID: requestID},
+			},
+		},
+		{
+			name:  "valid status",
+			query: url.Values{"status": []string{"7"}},
+			callFunc: func() {
+				service.EXPECT().ListRequests(gomock.Any(), gomock.Any(), auth).DoAndReturn(func(_ context.Context, criteria model.ListRequestsCriteria, _ *model.Auth) (*model.Requests, *errors.Error) {
+					if criteria.FilterRequest.Status != model.Submitted {
+						t.Errorf("want status: %v, got: %v", model.Submitted, criteria.FilterRequest.Status)
+					}
+					return &model.Requests{}, nil
+				})
+			},
+			want: result{
+				code: http.StatusOK,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.callFunc != nil {
+				test.callFunc()
+			}
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/requests?"+test.query.Encode(), nil)
+			handler.ListRequests(w, r)
+
+			result := w.Result()
+			defer result.Body.Close()
+
+			response := new(response)
+			json.NewDecoder(result.Body).Decode(response)
+
+			if test.want.code != result.StatusCode {
+				t.Errorf("want: %v, got: %v", test.want.code, result.StatusCode)
+			}
+
+			if test.want.response != nil && !reflect.DeepEqual(test.want.response, response) {
+				t.Errorf("want: %v, got: %v", test.want.response, response)
+			}
+		})
+	}
+}
+
+func TestCreateRequestMaterialCombination(t *testing.T) {
+	service := NewMockService(gomock.NewController(t))
+	handler := New(service)
+
+	requestID := "dummy-request-id"
+	auth := &model.Auth{UserID: "dummy-requester", Role: model.Requester, IsVerified: true}
+	ctx := context.WithValue(context.WithValue(context.Background(), middleware.RequestIDKey, requestID), middleware.AuthKey, auth)
+
+	body := `{"subject":"Bearings","isNew":true,"materials":[{"plant":"1000","type":"ERSA","uom":"PC","group":"G001","longText":"BEARING, BALL; 6205-2RS","attachments":["dummy-asset-id"]}]}`
+
+	tests := []struct {
+		name string
+		err  *errors.Error
+		want int
+	}{
+		{name: "type not allowed at plant", err: errors.New(errors.MaterialTypeIsNotAllowed), want: http.StatusUnprocessableEntity},
+		{name: "group not allowed for type", err: errors.New(errors.MaterialGroupIsNotAllowed), want: http.StatusUnprocessableEntity},
+		{name: "missing master data", err: errors.New(errors.MaterialPropertiesNotFound), want: http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service.EXPECT().CreateRequest(gomock.Any(), gomock.Any()).Return(nil, test.err)
+
+			w := httptest.NewRecorder()
+			handler.CreateRequest(w, httptest.NewRequestWithContext(ctx, http.MethodPost, "/requests", strings.NewReader(body)))
+
+			response := make(map[string]string)
+			json.NewDecoder(w.Body).Decode(&response)
+
+			if test.want != w.Code {
+				t.Errorf("want: %v, got: %v", test.want, w.Code)
+			}
+
+			if response["errorCode"] != test.err.Code() {
+				t.Errorf("want: %v, got: %v", test.err.Code(), response["errorCode"])
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./handler.go
+
+// Package handler is a generated GoMock package.
+package handler
+
+import (
+	context "context"
+	multipart "mime/multipart"
+	reflect "reflect"
+
+	model "github.com/dev-pt-bai/cataloging/internal/model"
+	errors "github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// AssignRequest mocks base method.
+func (m *MockService) AssignRequest(ctx context.Context, ID model.UUID, assignee string, assignedBy *model.Auth) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignRequest", ctx, ID, assignee, assignedBy)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// AssignRequest indicates an expected call of AssignRequest.
+func (mr *MockServiceMockRecorder) AssignRequest(ctx, ID, assignee, assignedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRequest", reflect.TypeOf((*MockService)(nil).AssignRequest), ctx, ID, assignee, assignedBy)
+}
+
+// BulkCreateRequest mocks base method.
+func (m *MockService) BulkCreateRequest(ctx context.Context, file multipart.File, filename string, r model.BulkCreateRequestRequest, requestedBy *model.Auth) (*model.Request, []model.MaterialDuplicates, []model.SpreadsheetRowError, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkCreateRequest", ctx, file, filename, r, requestedBy)
+	ret0, _ := ret[0].(*model.Request)
+	ret1, _ := ret[1].([]model.MaterialDuplicates)
+	ret2, _ := ret[2].([]model.SpreadsheetRowError)
+	ret3, _ := ret[3].(*errors.Error)
+	return ret0, ret1, ret2, ret3
+}
+
+// BulkCreateRequest indicates an expected call of BulkCreateRequest.
+func (mr *MockServiceMockRecorder) BulkCreateRequest(ctx, file, filename, r, requestedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreateRequest", reflect.TypeOf((*MockService)(nil).BulkCreateRequest), ctx, file, filename, r, requestedBy)
+}
+
+// ClaimRequest mocks base method.
+func (m *MockService) ClaimRequest(ctx context.Context, assignee *model.Auth) (*model.Request, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimRequest", ctx, assignee)
+	ret0, _ := ret[0].(*model.Request)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ClaimRequest indicates an expected call of ClaimRequest.
+func (mr *MockServiceMockRecorder) ClaimRequest(ctx, assignee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimRequest", reflect.TypeOf((*MockService)(nil).ClaimRequest), ctx, assignee)
+}
+
+// CreateComment mocks base method.
+func (m *MockService) CreateComment(ctx context.Context, c model.Comment, createdBy *model.Auth) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComment", ctx, c, createdBy)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// CreateComment indicates an expected call of CreateComment.
+func (mr *MockServiceMockRecorder) CreateComment(ctx, c, createdBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockService)(nil).CreateComment), ctx, c, createdBy)
+}
+
+// CreateRequest mocks base method.
+func (m *MockService) CreateRequest(ctx context.Context, r model.Request) ([]model.MaterialDuplicates, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRequest", ctx, r)
+	ret0, _ := ret[0].([]model.MaterialDuplicates)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// CreateRequest indicates an expected call of CreateRequest.
+func (mr *MockServiceMockRecorder) CreateRequest(ctx, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequest", reflect.TypeOf((*MockService)(nil).CreateRequest), ctx, r)
+}
+
+// DeleteComment mocks base method.
+func (m *MockService) DeleteComment(ctx context.Context, requestID, ID model.UUID, deletedBy *model.Auth) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, requestID, ID, deletedBy)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockServiceMockRecorder) DeleteComment(ctx, requestID, ID, deletedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockService)(nil).DeleteComment), ctx, requestID, ID, deletedBy)
+}
+
+// DeleteRequest mocks base method.
+func (m *MockService) DeleteRequest(ctx context.Context, ID model.UUID, requestedBy *model.Auth) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRequest", ctx, ID, requestedBy)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// DeleteRequest indicates an expected call of DeleteRequest.
+func (mr *MockServiceMockRecorder) DeleteRequest(ctx, ID, requestedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRequest", reflect.TypeOf((*MockService)(nil).DeleteRequest), ctx, ID, requestedBy)
+}
+
+// ExportIDoc mocks base method.
+func (m *MockService) ExportIDoc(ctx context.Context, IDs []model.UUID, requestedBy *model.Auth) ([]byte, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportIDoc", ctx, IDs, requestedBy)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ExportIDoc indicates an expected call of ExportIDoc.
+func (mr *MockServiceMockRecorder) ExportIDoc(ctx, IDs, requestedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportIDoc", reflect.TypeOf((*MockService)(nil).ExportIDoc), ctx, IDs, requestedBy)
+}
+
+// ExportRequest mocks base method.
+func (m *MockService) ExportRequest(ctx context.Context, ID model.UUID, requestedBy *model.Auth) ([]byte, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportRequest", ctx, ID, requestedBy)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ExportRequest indicates an expected call of ExportRequest.
+func (mr *MockServiceMockRecorder) ExportRequest(ctx, ID, requestedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportRequest", reflect.TypeOf((*MockService)(nil).ExportRequest), ctx, ID, requestedBy)
+}
+
+// FindDuplicates mocks base method.
+func (m *MockService) FindDuplicates(ctx context.Context, ID model.UUID, requestedBy *model.Auth) ([]model.MaterialDuplicates, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDuplicates", ctx, ID, requestedBy)
+	ret0, _ := ret[0].([]model.MaterialDuplicates)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// FindDuplicates indicates an expected call of FindDuplicates.
+func (mr *MockServiceMockRecorder) FindDuplicates(ctx, ID, requestedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDuplicates", reflect.TypeOf((*MockService)(nil).FindDuplicates), ctx, ID, requestedBy)
+}
+
+// GetRequest mocks base method.
+func (m *MockService) GetRequest(ctx context.Context, ID model.UUID, requestedBy *model.Auth) (*model.Request, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequest", ctx, ID, requestedBy)
+	ret0, _ := ret[0].(*model.Request)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// GetRequest indicates an expected call of GetRequest.
+func (mr *MockServiceMockRecorder) GetRequest(ctx, ID, requestedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequest", reflect.TypeOf((*MockService)(nil).GetRequest), ctx, ID, requestedBy)
+}
+
+// GetRequestDiff mocks base method.
+func (m *MockService) GetRequestDiff(ctx context.Context, ID model.UUID, requestedBy *model.Auth) ([]model.MaterialDiff, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestDiff", ctx, ID, requestedBy)
+	ret0, _ := ret[0].([]model.MaterialDiff)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// GetRequestDiff indicates an expected call of GetRequestDiff.
+func (mr *MockServiceMockRecorder) GetRequestDiff(ctx, ID, requestedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestDiff", reflect.TypeOf((*MockService)(nil).GetRequestDiff), ctx, ID, requestedBy)
+}
+
+// GetRequestHistory mocks base method.
+func (m *MockService) GetRequestHistory(ctx context.Context, ID model.UUID, requestedBy *model.Auth) ([]model.RequestHistory, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestHistory", ctx, ID, requestedBy)
+	ret0, _ := ret[0].([]model.RequestHistory)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// GetRequestHistory indicates an expected call of GetRequestHistory.
+func (mr *MockServiceMockRecorder) GetRequestHistory(ctx, ID, requestedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestHistory", reflect.TypeOf((*MockService)(nil).GetRequestHistory), ctx, ID, requestedBy)
+}
+
+// ListAssignedRequests mocks base method.
+func (m *MockService) ListAssignedRequests(ctx context.Context, criteria model.ListRequestsCriteria, assignee *model.Auth) (*model.Requests, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssignedRequests", ctx, criteria, assignee)
+	ret0, _ := ret[0].(*model.Requests)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListAssignedRequests indicates an expected call of ListAssignedRequests.
+func (mr *MockServiceMockRecorder) ListAssignedRequests(ctx, criteria, assignee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssignedRequests", reflect.TypeOf((*MockService)(nil).ListAssignedRequests), ctx, criteria, assignee)
+}
+
+// ListComments mocks base method.
+func (m *MockService) ListComments(ctx context.Context, requestID model.UUID, materialID *model.UUID, requestedBy *model.Auth) ([]*model.Comment, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComments", ctx, requestID, materialID, requestedBy)
+	ret0, _ := ret[0].([]*model.Comment)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListComments indicates an expected call of ListComments.
+func (mr *MockServiceMockRecorder) ListComments(ctx, requestID, materialID, requestedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComments", reflect.TypeOf((*MockService)(nil).ListComments), ctx, requestID, materialID, requestedBy)
+}
+
+// ListRequests mocks base method.
+func (m *MockService) ListRequests(ctx context.Context, criteria model.ListRequestsCriteria, requestedBy *model.Auth) (*model.Requests, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRequests", ctx, criteria, requestedBy)
+	ret0, _ := ret[0].(*model.Requests)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListRequests indicates an expected call of ListRequests.
+func (mr *MockServiceMockRecorder) ListRequests(ctx, criteria, requestedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRequests", reflect.TypeOf((*MockService)(nil).ListRequests), ctx, criteria, requestedBy)
+}
+
+// MarkMaterialDuplicate mocks base method.
+func (m *MockService) MarkMaterialDuplicate(ctx context.Context, requestID, materialID model.UUID, number string, markedBy *model.Auth) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkMaterialDuplicate", ctx, requestID, materialID, number, markedBy)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// MarkMaterialDuplicate indicates an expected call of MarkMaterialDuplicate.
+func (mr *MockServiceMockRecorder) MarkMaterialDuplicate(ctx, requestID, materialID, number, markedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkMaterialDuplicate", reflect.TypeOf((*MockService)(nil).MarkMaterialDuplicate), ctx, requestID, materialID, number, markedBy)
+}
+
+// ReviewMaterial mocks base method.
+func (m *MockService) ReviewMaterial(ctx context.Context, requestID model.UUID, review model.MaterialReview, reviewedBy *model.Auth) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewMaterial", ctx, requestID, review, reviewedBy)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// ReviewMaterial indicates an expected call of ReviewMaterial.
+func (mr *MockServiceMockRecorder) ReviewMaterial(ctx, requestID, review, reviewedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewMaterial", reflect.TypeOf((*MockService)(nil).ReviewMaterial), ctx, requestID, review, reviewedBy)
+}
+
+// TransitRequest mocks base method.
+func (m *MockService) TransitRequest(ctx context.Context, ID model.UUID, transition model.Transition, reason *string, actor *model.Auth) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitRequest", ctx, ID, transition, reason, actor)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// TransitRequest indicates an expected call of TransitRequest.
+func (mr *MockServiceMockRecorder) TransitRequest(ctx, ID, transition, reason, actor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitRequest", reflect.TypeOf((*MockService)(nil).TransitRequest), ctx, ID, transition, reason, actor)
+}
+
+// UpdateComment mocks base method.
+func (m *MockService) UpdateComment(ctx context.Context, c model.Comment, updatedBy *model.Auth) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateComment", ctx, c, updatedBy)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// UpdateComment indicates an expected call of UpdateComment.
+func (mr *MockServiceMockRecorder) UpdateComment(ctx, c, updatedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockService)(nil).UpdateComment), ctx, c, updatedBy)
+}
+
+// UpdateRequest mocks base method.
+func (m *MockService) UpdateRequest(ctx context.Context, r model.Request, requestedBy *model.Auth) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRequest", ctx, r, requestedBy)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// UpdateRequest indicates an expected call of UpdateRequest.
+func (mr *MockServiceMockRecorder) UpdateRequest(ctx, r, requestedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRequest", reflect.TypeOf((*MockService)(nil).UpdateRequest), ctx, r, requestedBy)
+}
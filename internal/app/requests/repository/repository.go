@@ -3,8 +3,11 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 
 	"github.com/dev-pt-bai/cataloging/internal/model"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/database/query"
 	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
 )
 
@@ -22,32 +25,208 @@ INSERT INTO requests (id, subject, is_new, requested_by, status)
 	WHERE EXISTS(SELECT 1 FROM users WHERE id = ? AND deleted_at = 0)`
 
 const CreateMaterialQuery = `
-INSERT INTO materials (id, number, plant_code, type_code, uom_code, group_code, equipment_code, manufacturer_code, short_text, long_text, note, status, request_id)
-	SELECT ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+INSERT INTO materials (id, number, plant_code, type_code, uom_code, group_code, equipment_code, manufacturer_code, part_number, short_text, long_text, note, status, request_id, revision_of)
+	SELECT ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 	WHERE EXISTS(SELECT 1 FROM plants WHERE code = ? AND deleted_at = 0)
 	AND EXISTS(SELECT 1 FROM material_types WHERE code = ? AND deleted_at = 0)
 	AND EXISTS(SELECT 1 FROM material_uoms WHERE code = ? AND deleted_at = 0)
 	AND EXISTS(SELECT 1 FROM material_groups WHERE code = ? AND deleted_at = 0)
 	AND (? IS NULL OR EXISTS(SELECT 1 FROM manufacturers WHERE code = ? AND deleted_at = 0))`
 
+// CheckMaterialCombinationQuery is closed by default: a plant only accepts the material types
+// linked to it and a material type only accepts the material groups linked to it.
+const CheckMaterialCombinationQuery = `
+SELECT
+	EXISTS(SELECT 1 FROM plant_material_types pmt JOIN plants p ON pmt.plant_code = p.code AND p.deleted_at = 0 JOIN material_types mt ON pmt.type_code = mt.code AND mt.deleted_at = 0 WHERE pmt.plant_code = ? AND pmt.type_code = ?),
+	EXISTS(SELECT 1 FROM material_type_groups mtg JOIN material_types mt ON mtg.type_code = mt.code AND mt.deleted_at = 0 JOIN material_groups mg ON mtg.group_code = mg.code AND mg.deleted_at = 0 WHERE mtg.type_code = ? AND mtg.group_code = ?)`
+
 const UpdateMaterialAttachmentQuery = `
 UPDATE assets SET material_id = ?, updated_at = (UNIX_TIMESTAMP())
 	WHERE id = ? AND created_by = ? AND material_id IS NULL AND deleted_at = 0`
 
 const GetRequestQuery = `
 WITH
-    cte1 AS (SELECT id, subject, is_new, requested_by, status, created_at, updated_at FROM requests WHERE id = ? AND deleted_at = 0),
+    cte1 AS (SELECT id, subject, is_new, requested_by, assigned_to, status, due_at, escalated_at, created_at, updated_at FROM requests WHERE id = ? AND deleted_at = 0),
 	cte2 AS (SELECT JSON_OBJECT('id', id, 'name', name, 'email', email, 'role', role, 'isVerified', is_verified, 'createdAt', created_at, 'updatedAt', updated_at) AS requester FROM users WHERE id = (SELECT requested_by FROM cte1) AND deleted_at = 0),
-	cte3 AS (SELECT id, number, plant_code, type_code, uom_code, group_code, equipment_code, manufacturer_code, short_text, long_text, note, status, request_id, created_at, updated_at FROM materials WHERE request_id = (SELECT id FROM cte1) AND deleted_at = 0),
-	cte4 AS (SELECT code, JSON_OBJECT('code', code, 'description', description, 'createdAt', created_at, 'updatedAt', updated_at) AS plant FROM plants WHERE code IN (SELECT plant_code FROM cte3) AND deleted_at = 0),
-	cte5 AS (SELECT code, JSON_OBJECT('code', code, 'description', description, 'createdAt', created_at, 'updatedAt', updated_at) AS type FROM material_types WHERE code IN (SELECT type_code FROM cte3) AND deleted_at = 0),
-	cte6 AS (SELECT code, JSON_OBJECT('code', code, 'description', description, 'createdAt', created_at, 'updatedAt', updated_at) AS uom FROM material_uoms WHERE code IN (SELECT uom_code FROM cte3) AND deleted_at = 0),
-	cte7 AS (SELECT code, JSON_OBJECT('code', code, 'description', description, 'createdAt', created_at, 'updatedAt', updated_at) AS mgroup FROM material_groups WHERE code IN (SELECT group_code FROM cte3) AND deleted_at = 0),
-	cte8 AS (SELECT code, JSON_OBJECT('code', code, 'description', description, 'createdAt', created_at, 'updatedAt', updated_at) AS manufacturer FROM manufacturers WHERE code IN (SELECT manufacturer_code FROM cte3) AND deleted_at = 0),
-	cte9 AS (SELECT material_id, JSON_ARRAYAGG(JSON_OBJECT('id', id, 'name', name, 'size', size, 'downloadURL', download_url, 'webURL', web_url, 'createdBy', created_by, 'materialID', material_id, "createdAt", created_at, 'updatedAt', updated_at)) AS attachments FROM assets WHERE material_id IN (SELECT id FROM cte3) AND deleted_at = 0 GROUP BY material_id),
-	cte10 AS (SELECT JSON_ARRAYAGG(JSON_OBJECT('id', id, 'number', number, 'plant', plant, 'type', type, 'uom', uom, 'group', mgroup, 'equipmentCode', equipment_code, 'manufacturer', manufacturer, 'shortText', short_text, 'longText', long_text, 'note', note, 'status', status, 'requestID', request_id, 'createdAt', created_at, 'updatedAt', updated_at, 'attachments', attachments)) AS materials FROM cte3 JOIN cte4 ON cte3.plant_code = cte4.code JOIN cte5 ON cte3.type_code = cte5.code JOIN cte6 ON cte3.uom_code = cte6.code JOIN cte7 ON cte3.group_code = cte7.code LEFT JOIN cte8 ON cte3.manufacturer_code = cte8.code JOIN cte9 ON cte3.id = cte9.material_id)
-SELECT JSON_OBJECT('id', id, 'subject', subject, 'is_new', is_new, 'requestedBy', requester, 'status', status, 'createdAt', created_at, 'updatedAt', updated_at, 'materials', materials) AS request
-	FROM cte1, cte2, cte10`
+	cte3 AS (SELECT JSON_ARRAYAGG(` + query.MaterialRecord + `) AS materials FROM materials WHERE request_id = (SELECT id FROM cte1) AND deleted_at = 0),
+	cte4 AS (SELECT JSON_ARRAYAGG(JSON_OBJECT('id', h.id, 'requestID', h.request_id, 'fromStatus', h.from_status, 'toStatus', h.to_status, 'actor', JSON_OBJECT('id', h.actor, 'name', u.name, 'email', u.email), 'reason', h.reason, 'createdAt', h.created_at)) AS history FROM request_status_history h LEFT JOIN users u ON h.actor = u.id AND u.deleted_at = 0 WHERE h.request_id = (SELECT id FROM cte1))
+SELECT JSON_OBJECT('id', id, 'subject', subject, 'is_new', is_new, 'requestedBy', requester, 'assignedTo', (SELECT JSON_OBJECT('id', u.id, 'name', u.name, 'email', u.email) FROM users u WHERE u.id = cte1.assigned_to AND u.deleted_at = 0), 'status', status, 'dueAt', due_at, 'escalatedAt', escalated_at, 'createdAt', created_at, 'updatedAt', updated_at, 'materials', materials, 'history', history) AS request
+	FROM cte1, cte2, cte3, cte4`
+
+const CreateRequestHistoryQuery = `
+INSERT INTO request_status_history (id, request_id, from_status, to_status, actor, reason)
+	VALUES (?, ?, ?, ?, ?, ?)`
+
+const ListRequestsQuery = `
+WITH
+	cte1 AS (SELECT JSON_OBJECT('id', id, 'subject', subject, 'isNew', is_new, 'requestedBy', (SELECT JSON_OBJECT('id', u.id, 'name', u.name, 'email', u.email, 'role', u.role, 'isVerified', u.is_verified, 'createdAt', u.created_at, 'updatedAt', u.updated_at) FROM users u WHERE u.id = requests.requested_by), 'assignedTo', (SELECT JSON_OBJECT('id', u.id, 'name', u.name, 'email', u.email) FROM users u WHERE u.id = requests.assigned_to AND u.deleted_at = 0), 'status', status, 'dueAt', due_at, 'escalatedAt', escalated_at, 'createdAt', created_at, 'updatedAt', updated_at) AS record FROM requests `
+
+const LockRequestQuery = `
+SELECT status FROM requests
+	WHERE id = ? AND deleted_at = 0
+	FOR UPDATE`
+
+const UpdateRequestQuery = `
+UPDATE requests SET subject = ?, is_new = ?, updated_at = (UNIX_TIMESTAMP())
+	WHERE id = ? AND deleted_at = 0`
+
+const ReleaseRequestAttachmentsQuery = `
+UPDATE assets SET material_id = NULL, updated_at = (UNIX_TIMESTAMP())
+	WHERE material_id IN (SELECT id FROM materials WHERE request_id = ? AND deleted_at = 0) AND deleted_at = 0`
+
+const DeleteRequestMaterialsQuery = `
+UPDATE materials SET deleted_at = (UNIX_TIMESTAMP())
+	WHERE request_id = ? AND deleted_at = 0`
+
+const UpdateMaterialQuery = `
+UPDATE materials SET number = ?, plant_code = ?, type_code = ?, uom_code = ?, group_code = ?, equipment_code = ?, manufacturer_code = ?, part_number = ?, short_text = ?, long_text = ?, note = ?, status = ?, revision_of = ?, updated_at = (UNIX_TIMESTAMP()), deleted_at = 0
+	WHERE id = ? AND request_id = ?
+	AND EXISTS(SELECT 1 FROM plants WHERE code = ? AND deleted_at = 0)
+	AND EXISTS(SELECT 1 FROM material_types WHERE code = ? AND deleted_at = 0)
+	AND EXISTS(SELECT 1 FROM material_uoms WHERE code = ? AND deleted_at = 0)
+	AND EXISTS(SELECT 1 FROM material_groups WHERE code = ? AND deleted_at = 0)
+	AND (? IS NULL OR EXISTS(SELECT 1 FROM manufacturers WHERE code = ? AND deleted_at = 0))`
+
+const DeleteRequestQuery = `
+UPDATE requests SET deleted_at = (UNIX_TIMESTAMP())
+	WHERE id = ? AND deleted_at = 0`
+
+const CreateCommentQuery = `
+INSERT INTO comments (id, request_id, material_id, parent_id, content, created_by)
+	VALUES (?, ?, ?, ?, ?, ?)`
+
+const CreateCommentMentionQuery = `
+INSERT IGNORE INTO comment_mentions (comment_id, user_id)
+	SELECT ?, id FROM users WHERE id = ? AND deleted_at = 0`
+
+const DeleteCommentMentionsQuery = `
+DELETE FROM comment_mentions
+	WHERE comment_id = ?`
+
+const commentRecord = `JSON_OBJECT('id', c.id, 'requestID', c.request_id, 'materialID', c.material_id, 'parentID', c.parent_id, 'content', c.content, 'mentions', (SELECT JSON_ARRAYAGG(cm.user_id) FROM comment_mentions cm WHERE cm.comment_id = c.id), 'createdBy', JSON_OBJECT('id', c.created_by, 'name', u.name, 'email', u.email), 'createdAt', c.created_at, 'updatedAt', c.updated_at)`
+
+const ListCommentsQuery = `
+SELECT JSON_ARRAYAGG(` + commentRecord + `)
+	FROM comments c LEFT JOIN users u ON c.created_by = u.id AND u.deleted_at = 0
+	WHERE c.request_id = ? AND (? IS NULL OR c.material_id = ?) AND c.deleted_at = 0`
+
+const GetCommentQuery = `
+SELECT ` + commentRecord + `
+	FROM comments c LEFT JOIN users u ON c.created_by = u.id AND u.deleted_at = 0
+	WHERE c.id = ? AND c.deleted_at = 0`
+
+const UpdateCommentQuery = `
+UPDATE comments SET content = ?, updated_at = (UNIX_TIMESTAMP())
+	WHERE id = ? AND deleted_at = 0`
+
+const DeleteCommentQuery = `
+UPDATE comments SET deleted_at = (UNIX_TIMESTAMP())
+	WHERE (id = ? OR parent_id = ?) AND deleted_at = 0`
+
+const ListCommentRecipientsQuery = `
+SELECT id, name, email
+	FROM users
+	WHERE deleted_at = 0 AND id <> ?
+	AND (
+		id = (SELECT requested_by FROM requests WHERE id = ?)
+		OR id = (SELECT created_by FROM comments WHERE id = ?)
+		OR id IN (SELECT user_id FROM comment_mentions WHERE comment_id = ?)
+	)`
+
+const ListUsersByRoleQuery = `
+SELECT id, name, email
+	FROM users
+	WHERE role = ? AND is_verified = 1 AND deleted_at = 0`
+
+const ClaimRequestQuery = `
+SELECT id FROM requests
+	WHERE status = ? AND assigned_to IS NULL AND deleted_at = 0
+	ORDER BY created_at
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED`
+
+const GetAssigneeRoleQuery = `
+SELECT role FROM users
+	WHERE id = ? AND is_verified = 1 AND deleted_at = 0`
+
+const AssignRequestQuery = `
+UPDATE requests SET assigned_to = ?, updated_at = (UNIX_TIMESTAMP())
+	WHERE id = ? AND deleted_at = 0`
+
+const UpdateRequestStatusQuery = `
+UPDATE requests SET status = ?, updated_at = (UNIX_TIMESTAMP())
+	WHERE id = ? AND status = ? AND deleted_at = 0`
+
+const UpdateMaterialStatusQuery = `
+UPDATE materials SET status = ?, updated_at = (UNIX_TIMESTAMP())
+	WHERE request_id = ? AND status <> ? AND deleted_at = 0`
+
+const UpdateRequestDueAtQuery = `
+UPDATE requests SET due_at = ?, escalated_at = NULL
+	WHERE id = ? AND status = ? AND deleted_at = 0`
+
+const ListOverdueRequestsQuery = `
+SELECT JSON_OBJECT('id', r.id, 'subject', r.subject, 'isNew', r.is_new, 'requestedBy', JSON_OBJECT('id', r.requested_by), 'assignedTo', IF(u.id IS NULL, NULL, JSON_OBJECT('id', u.id, 'name', u.name, 'email', u.email)), 'status', r.status, 'dueAt', r.due_at, 'escalatedAt', r.escalated_at, 'createdAt', r.created_at, 'updatedAt', r.updated_at)
+	FROM requests r
+	LEFT JOIN users u ON r.assigned_to = u.id AND u.deleted_at = 0
+	WHERE r.due_at < ? AND r.escalated_at IS NULL AND r.deleted_at = 0
+	ORDER BY r.due_at`
+
+const MarkRequestEscalatedQuery = `
+UPDATE requests SET escalated_at = (UNIX_TIMESTAMP())
+	WHERE id = ? AND due_at = ? AND escalated_at IS NULL AND deleted_at = 0`
+
+const AssignMaterialNumberQuery = `
+UPDATE materials SET number = ?, updated_at = (UNIX_TIMESTAMP())
+	WHERE id = ? AND status = ? AND number IS NULL AND deleted_at = 0`
+
+const GetMaxMaterialNumberQuery = `
+SELECT COALESCE(MAX(CAST(number AS UNSIGNED)), 0) FROM materials
+	WHERE number REGEXP '^[0-9]+$' AND CAST(number AS UNSIGNED) BETWEEN ? AND ?`
+
+const PublishMaterialQuery = `
+UPDATE materials SET number = ?, status = ?, updated_at = (UNIX_TIMESTAMP())
+	WHERE id = ? AND status = ? AND deleted_at = 0`
+
+const ListDuplicateCandidatesQuery = `
+SELECT m.id, m.number, m.plant_code, m.type_code, m.manufacturer_code, m.part_number, m.short_text, m.long_text, m.status, m.request_id
+	FROM materials m
+	JOIN requests r ON m.request_id = r.id AND r.deleted_at = 0
+	WHERE m.request_id <> ? AND m.duplicate_of IS NULL AND m.deleted_at = 0
+	AND (m.status = ? OR (r.status IN (?, ?, ?, ?) AND m.status <> ?))
+	AND `
+
+const GetPublishedMaterialQuery = `
+SELECT 1 FROM materials
+	WHERE number = ? AND status = ? AND deleted_at = 0
+	LIMIT 1`
+
+const MarkMaterialDuplicateQuery = `
+UPDATE materials SET duplicate_of = ?, updated_at = (UNIX_TIMESTAMP())
+	WHERE id = ? AND request_id = ? AND deleted_at = 0`
+
+const GetPublishedMaterialIDQuery = `
+SELECT id FROM materials
+	WHERE number = ? AND status = ? AND deleted_at = 0
+	ORDER BY plant_code = ? DESC, updated_at DESC
+	LIMIT 1`
+
+const ListPublishedMaterialsQuery = `
+SELECT id, number, plant_code, type_code, uom_code, group_code, manufacturer_code, equipment_code, short_text, long_text
+	FROM materials
+	WHERE status = ? AND deleted_at = 0 AND id IN `
+
+const ReviewMaterialQuery = `
+UPDATE materials SET status = ?, review_decision = ?, review_reason_code = ?, review_note = ?, reviewed_by = ?, reviewed_at = (UNIX_TIMESTAMP()), updated_at = (UNIX_TIMESTAMP())
+	WHERE id = ? AND request_id = ? AND deleted_at = 0`
+
+const ResetMaterialReviewQuery = `
+UPDATE materials SET review_decision = NULL, review_reason_code = NULL, review_note = NULL, reviewed_by = NULL, reviewed_at = NULL
+	WHERE request_id = ? AND status <> ? AND deleted_at = 0`
+
+const ListMaterialStatusesQuery = `
+SELECT status FROM materials
+	WHERE request_id = ? AND deleted_at = 0`
 
 func (r *Repository) CreateRequest(ctx context.Context, request model.Request) *errors.Error {
 	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelDefault})
@@ -70,6 +249,10 @@ func (r *Repository) CreateRequest(ctx context.Context, request model.Request) *
 		return errors.New(errors.UserNotFound)
 	}
 
+	if _, err = tx.ExecContext(ctx, CreateRequestHistoryQuery, model.NewUUID(), request.ID, nil, request.Status, request.RequestedBy.ID, nil); err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
 	stmt1, err := tx.PrepareContext(ctx, CreateMaterialQuery)
 	if err != nil {
 		return errors.New(errors.PrepareStatementFailure).Wrap(err)
@@ -82,9 +265,19 @@ func (r *Repository) CreateRequest(ctx context.Context, request model.Request) *
 	}
 	defer stmt2.Close()
 
+	stmt3, err := tx.PrepareContext(ctx, CheckMaterialCombinationQuery)
+	if err != nil {
+		return errors.New(errors.PrepareStatementFailure).Wrap(err)
+	}
+	defer stmt3.Close()
+
 	for i := range request.Materials {
 		m := request.Materials[i]
-		res, err = stmt1.ExecContext(ctx, m.ID, m.Number, m.Plant.Code, m.Type.Code, m.UoM.Code, m.Group.Code, m.EquipmentCode, m.Manufacturer.SafeCode(), m.ShortText, m.LongText, m.Note, m.Status, request.ID, m.Plant.Code, m.Type.Code, m.UoM.Code, m.Group.Code, m.Manufacturer.SafeCode(), m.Manufacturer.SafeCode())
+		if err := checkMaterialCombination(ctx, stmt3, m); err != nil {
+			return err
+		}
+
+		res, err = stmt1.ExecContext(ctx, m.ID, m.Number, m.Plant.Code, m.Type.Code, m.UoM.Code, m.Group.Code, m.EquipmentCode, m.Manufacturer.SafeCode(), m.PartNumber, m.ShortText, m.LongText, m.Note, m.Status, request.ID, m.RevisionOf, m.Plant.Code, m.Type.Code, m.UoM.Code, m.Group.Code, m.Manufacturer.SafeCode(), m.Manufacturer.SafeCode())
 		if err != nil {
 			return errors.New(errors.RunQueryFailure).Wrap(err)
 		}
@@ -123,6 +316,28 @@ func (r *Repository) CreateRequest(ctx context.Context, request model.Request) *
 	return nil
 }
 
+func checkMaterialCombination(ctx context.Context, stmt *sql.Stmt, m model.Material) *errors.Error {
+	var typeAllowed, groupAllowed bool
+	err := stmt.QueryRowContext(ctx, m.Plant.Code, m.Type.Code, m.Type.Code, m.Group.Code).Scan(&typeAllowed, &groupAllowed)
+	if err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return materialCombinationError(m, typeAllowed, groupAllowed)
+}
+
+func materialCombinationError(m model.Material, typeAllowed, groupAllowed bool) *errors.Error {
+	if !typeAllowed {
+		return errors.New(errors.MaterialTypeIsNotAllowed).Wrap(fmt.Errorf("material type %s is not allowed at plant %s", m.Type.Code, m.Plant.Code))
+	}
+
+	if !groupAllowed {
+		return errors.New(errors.MaterialGroupIsNotAllowed).Wrap(fmt.Errorf("material group %s is not allowed for material type %s", m.Group.Code, m.Type.Code))
+	}
+
+	return nil
+}
+
 func (r *Repository) GetRequest(ctx context.Context, ID model.UUID) (*model.Request, *errors.Error) {
 	request := new(model.Request)
 	err := r.db.QueryRowContext(ctx, GetRequestQuery, ID).Scan(&request)
@@ -135,3 +350,838 @@ func (r *Repository) GetRequest(ctx context.Context, ID model.UUID) (*model.Requ
 
 	return request, nil
 }
+
+func (r *Repository) UpdateRequest(ctx context.Context, request model.Request, existingMaterialIDs map[model.UUID]struct{}) *errors.Error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelDefault})
+	if err != nil {
+		return errors.New(errors.StartingTransactionFailure).Wrap(err)
+	}
+	defer tx.Rollback()
+
+	status, errLock := r.lockRequest(ctx, tx, request.ID)
+	if errLock != nil {
+		return errLock
+	}
+
+	if status != model.Draft {
+		return errors.New(errors.RequestIsNotEditable)
+	}
+
+	if _, err = tx.ExecContext(ctx, UpdateRequestQuery, request.Subject, request.IsNew, request.ID); err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	if _, err = tx.ExecContext(ctx, ReleaseRequestAttachmentsQuery, request.ID); err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	if _, err = tx.ExecContext(ctx, DeleteRequestMaterialsQuery, request.ID); err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	stmt1, err := tx.PrepareContext(ctx, CreateMaterialQuery)
+	if err != nil {
+		return errors.New(errors.PrepareStatementFailure).Wrap(err)
+	}
+	defer stmt1.Close()
+
+	stmt2, err := tx.PrepareContext(ctx, UpdateMaterialQuery)
+	if err != nil {
+		return errors.New(errors.PrepareStatementFailure).Wrap(err)
+	}
+	defer stmt2.Close()
+
+	stmt3, err := tx.PrepareContext(ctx, UpdateMaterialAttachmentQuery)
+	if err != nil {
+		return errors.New(errors.PrepareStatementFailure).Wrap(err)
+	}
+	defer stmt3.Close()
+
+	stmt4, err := tx.PrepareContext(ctx, CheckMaterialCombinationQuery)
+	if err != nil {
+		return errors.New(errors.PrepareStatementFailure).Wrap(err)
+	}
+	defer stmt4.Close()
+
+	var res sql.Result
+	var row int64
+	for i := range request.Materials {
+		m := request.Materials[i]
+		if err := checkMaterialCombination(ctx, stmt4, m); err != nil {
+			return err
+		}
+
+		if _, exists := existingMaterialIDs[m.ID]; exists {
+			res, err = stmt2.ExecContext(ctx, m.Number, m.Plant.Code, m.Type.Code, m.UoM.Code, m.Group.Code, m.EquipmentCode, m.Manufacturer.SafeCode(), m.PartNumber, m.ShortText, m.LongText, m.Note, m.Status, m.RevisionOf, m.ID, request.ID, m.Plant.Code, m.Type.Code, m.UoM.Code, m.Group.Code, m.Manufacturer.SafeCode(), m.Manufacturer.SafeCode())
+		} else {
+			res, err = stmt1.ExecContext(ctx, m.ID, m.Number, m.Plant.Code, m.Type.Code, m.UoM.Code, m.Group.Code, m.EquipmentCode, m.Manufacturer.SafeCode(), m.PartNumber, m.ShortText, m.LongText, m.Note, m.Status, request.ID, m.RevisionOf, m.Plant.Code, m.Type.Code, m.UoM.Code, m.Group.Code, m.Manufacturer.SafeCode(), m.Manufacturer.SafeCode())
+		}
+		if err != nil {
+			if errors.HasMySQLErrCode(err, 1062) {
+				return errors.New(errors.MaterialNotFound).Wrap(err)
+			}
+			return errors.New(errors.RunQueryFailure).Wrap(err)
+		}
+
+		row, err = res.RowsAffected()
+		if err != nil {
+			return errors.New(errors.RowsAffectedFailure).Wrap(err)
+		}
+
+		if row < 1 {
+			return errors.New(errors.MaterialPropertiesNotFound)
+		}
+
+		for j := range m.Attachments {
+			a := m.Attachments[j]
+			res, err = stmt3.ExecContext(ctx, m.ID, a.ID, request.RequestedBy.ID)
+			if err != nil {
+				return errors.New(errors.RunQueryFailure).Wrap(err)
+			}
+
+			row, err = res.RowsAffected()
+			if err != nil {
+				return errors.New(errors.RowsAffectedFailure).Wrap(err)
+			}
+
+			if row < 1 {
+				return errors.New(errors.AssetNotFound)
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.New(errors.CommittingTransactionFailure).Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteRequest(ctx context.Context, ID model.UUID) *errors.Error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelDefault})
+	if err != nil {
+		return errors.New(errors.StartingTransactionFailure).Wrap(err)
+	}
+	defer tx.Rollback()
+
+	status, errLock := r.lockRequest(ctx, tx, ID)
+	if errLock != nil {
+		return errLock
+	}
+
+	if status != model.Draft {
+		return errors.New(errors.RequestIsNotEditable)
+	}
+
+	if _, err = tx.ExecContext(ctx, ReleaseRequestAttachmentsQuery, ID); err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	if _, err = tx.ExecContext(ctx, DeleteRequestMaterialsQuery, ID); err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	if _, err = tx.ExecContext(ctx, DeleteRequestQuery, ID); err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.New(errors.CommittingTransactionFailure).Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ReviewMaterial(ctx context.Context, requestID model.UUID, stage model.Status, review model.MaterialReview) *errors.Error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelDefault})
+	if err != nil {
+		return errors.New(errors.StartingTransactionFailure).Wrap(err)
+	}
+	defer tx.Rollback()
+
+	status, errLock := r.lockRequest(ctx, tx, requestID)
+	if errLock != nil {
+		return errLock
+	}
+
+	if status != stage {
+		return errors.New(errors.RequestIsNotReviewable)
+	}
+
+	res, err := tx.ExecContext(ctx, ReviewMaterialQuery, review.Decision.MaterialStatus(stage), review.Decision, review.ReasonCode, review.Note, review.ReviewedBy, review.MaterialID, requestID)
+	if err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	row, err := res.RowsAffected()
+	if err != nil {
+		return errors.New(errors.RowsAffectedFailure).Wrap(err)
+	}
+
+	if row < 1 {
+		return errors.New(errors.MaterialNotFound)
+	}
+
+	rows, err := tx.QueryContext(ctx, ListMaterialStatusesQuery, requestID)
+	if err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	defer rows.Close()
+
+	statuses := make([]model.Status, 0, 5)
+	for rows.Next() {
+		var s model.Status
+		if err = rows.Scan(&s); err != nil {
+			return errors.New(errors.ScanRowsFailure).Wrap(err)
+		}
+		statuses = append(statuses, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return errors.New(errors.ScanRowsFailure).Wrap(err)
+	}
+
+	if to, isDecided := model.DeriveRequestStatus(stage, statuses); isDecided {
+		if _, err = tx.ExecContext(ctx, UpdateRequestStatusQuery, to, requestID, stage); err != nil {
+			return errors.New(errors.RunQueryFailure).Wrap(err)
+		}
+
+		history := model.NewRequestHistory(requestID, &stage, to, &model.Auth{UserID: review.ReviewedBy}, nil)
+		if _, err = tx.ExecContext(ctx, CreateRequestHistoryQuery, history.ID, history.RequestID, history.FromStatus, history.ToStatus, history.Actor.ID, history.Reason); err != nil {
+			return errors.New(errors.RunQueryFailure).Wrap(err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.New(errors.CommittingTransactionFailure).Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ClaimRequest(ctx context.Context, assignee string) (*model.UUID, *errors.Error) {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelDefault})
+	if err != nil {
+		return nil, errors.New(errors.StartingTransactionFailure).Wrap(err)
+	}
+	defer tx.Rollback()
+
+	ID := new(model.UUID)
+	if err = tx.QueryRowContext(ctx, ClaimRequestQuery, model.Submitted).Scan(ID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.New(errors.UnassignedRequestNotFound)
+		}
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	if _, err = tx.ExecContext(ctx, AssignRequestQuery, assignee, ID); err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.New(errors.CommittingTransactionFailure).Wrap(err)
+	}
+
+	return ID, nil
+}
+
+func (r *Repository) AssignRequest(ctx context.Context, ID model.UUID, assignee string) *errors.Error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelDefault})
+	if err != nil {
+		return errors.New(errors.StartingTransactionFailure).Wrap(err)
+	}
+	defer tx.Rollback()
+
+	status, errLock := r.lockRequest(ctx, tx, ID)
+	if errLock != nil {
+		return errLock
+	}
+
+	if !status.IsReviewable() {
+		return errors.New(errors.RequestIsNotReviewable)
+	}
+
+	var role model.Role
+	if err = tx.QueryRowContext(ctx, GetAssigneeRoleQuery, assignee).Scan(&role); err != nil {
+		if err == sql.ErrNoRows {
+			return errors.New(errors.UserNotFound)
+		}
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	if role != model.Cataloger && role != model.Administrator {
+		return errors.New(errors.InvalidAssignee)
+	}
+
+	if _, err = tx.ExecContext(ctx, AssignRequestQuery, assignee, ID); err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.New(errors.CommittingTransactionFailure).Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) lockRequest(ctx context.Context, tx *sql.Tx, ID model.UUID) (model.Status, *errors.Error) {
+	var status model.Status
+	if err := tx.QueryRowContext(ctx, LockRequestQuery, ID).Scan(&status); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, errors.New(errors.RequestNotFound)
+		}
+		return 0, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return status, nil
+}
+
+func (r *Repository) ListRequests(ctx context.Context, criteria model.ListRequestsCriteria) (*model.Requests, *errors.Error) {
+	query, args, err := r.buildListRequestsQuery(criteria)
+	if err != nil {
+		return nil, errors.New(errors.BuildQueryFailure).Wrap(err)
+	}
+
+	requests := new(model.Requests)
+	err = r.db.QueryRowContext(ctx, query, args...).Scan(&requests)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return requests, nil
+}
+
+type listParam struct {
+	q    strings.Builder
+	args []any
+}
+
+func (r *Repository) buildListRequestsQuery(criteria model.ListRequestsCriteria) (string, []any, error) {
+	param := listParam{
+		q:    strings.Builder{},
+		args: make([]any, 0, 10),
+	}
+	param.q.WriteString(ListRequestsQuery)
+
+	r.filterRequest(criteria.FilterRequest, &param)
+	if err := r.sort(criteria.Sort, &param, model.IsAvailableToSortRequest); err != nil {
+		return "", nil, err
+	}
+	if err := r.paginate(criteria.Page, &param); err != nil {
+		return "", nil, err
+	}
+
+	return param.q.String(), param.args, nil
+}
+
+func (r *Repository) filterRequest(filter model.FilterRequest, param *listParam) {
+	whereClauses := make([]string, 0, 10)
+	whereClauses = append(whereClauses, "deleted_at = 0 ")
+
+	if filter.Status != 0 {
+		whereClauses = append(whereClauses, "status = ? ")
+		param.args = append(param.args, filter.Status)
+	}
+
+	if len(filter.RequestedBy) != 0 {
+		whereClauses = append(whereClauses, "requested_by = ? ")
+		param.args = append(param.args, filter.RequestedBy)
+	}
+
+	if len(filter.AssignedTo) != 0 {
+		whereClauses = append(whereClauses, "assigned_to = ? ")
+		param.args = append(param.args, filter.AssignedTo)
+	}
+
+	if filter.IsNew != nil {
+		whereClauses = append(whereClauses, "is_new = ? ")
+		param.args = append(param.args, *filter.IsNew)
+	}
+
+	if len(filter.Subject) != 0 {
+		whereClauses = append(whereClauses, "subject LIKE ? ")
+		param.args = append(param.args, fmt.Sprintf("%%%s%%", filter.Subject))
+	}
+
+	if filter.CreatedFrom != 0 {
+		whereClauses = append(whereClauses, "created_at >= ? ")
+		param.args = append(param.args, filter.CreatedFrom)
+	}
+
+	if filter.CreatedTo != 0 {
+		whereClauses = append(whereClauses, "created_at <= ? ")
+		param.args = append(param.args, filter.CreatedTo)
+	}
+
+	if len(filter.PlantCode) != 0 {
+		whereClauses = append(whereClauses, "EXISTS(SELECT 1 FROM materials m WHERE m.request_id = requests.id AND m.plant_code = ? AND m.deleted_at = 0) ")
+		param.args = append(param.args, filter.PlantCode)
+	}
+
+	if len(filter.GroupCode) != 0 {
+		whereClauses = append(whereClauses, "EXISTS(SELECT 1 FROM materials m WHERE m.request_id = requests.id AND m.group_code = ? AND m.deleted_at = 0) ")
+		param.args = append(param.args, filter.GroupCode)
+	}
+
+	param.q.WriteString(fmt.Sprintf("WHERE %s ", strings.Join(whereClauses, "AND ")))
+}
+
+func (r *Repository) sort(sortCriteria model.Sort, param *listParam, isAvailable func(string) bool) *errors.Error {
+	if len(sortCriteria.FieldName) == 0 {
+		param.q.WriteString("ORDER BY created_at DESC), ")
+		return nil
+	}
+
+	if !isAvailable(sortCriteria.FieldName) {
+		return errors.New(errors.UnknownField)
+	}
+	param.q.WriteString(fmt.Sprintf("ORDER BY %s ", sortCriteria.FieldName))
+
+	if sortCriteria.IsDescending {
+		param.q.WriteString("DESC), ")
+		return nil
+	}
+	param.q.WriteString("ASC), ")
+
+	return nil
+}
+
+func (r *Repository) paginate(page model.Page, param *listParam) *errors.Error {
+	param.q.WriteString("cte2 AS (SELECT record FROM cte1 ")
+
+	if page.ItemPerPage < 1 || page.ItemPerPage > 20 {
+		return errors.New(errors.InvalidItemNumberPerPage)
+	}
+
+	if page.Number < 1 {
+		return errors.New(errors.InvalidPageNumber)
+	}
+
+	param.q.WriteString("LIMIT ? ")
+	param.args = append(param.args, page.ItemPerPage)
+
+	param.q.WriteString("OFFSET ?), ")
+	param.args = append(param.args, (page.Number-1)*page.ItemPerPage)
+
+	param.q.WriteString(`
+	cte3 AS (SELECT JSON_ARRAYAGG(record) AS data FROM cte2),
+	cte4 AS (SELECT COUNT(*) AS count FROM cte1)
+	SELECT JSON_OBJECT('data', COALESCE(data, CAST('[]' AS JSON)), 'count', count) FROM cte3 JOIN cte4`)
+
+	return nil
+}
+
+func (r *Repository) UpdateRequestStatus(ctx context.Context, history model.RequestHistory) *errors.Error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelDefault})
+	if err != nil {
+		return errors.New(errors.StartingTransactionFailure).Wrap(err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, UpdateRequestStatusQuery, history.ToStatus, history.RequestID, history.FromStatus)
+	if err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	row, err := res.RowsAffected()
+	if err != nil {
+		return errors.New(errors.RowsAffectedFailure).Wrap(err)
+	}
+
+	if row < 1 {
+		return errors.New(errors.InvalidRequestStatusTransition)
+	}
+
+	if _, err = tx.ExecContext(ctx, UpdateMaterialStatusQuery, history.ToStatus.MaterialStatus(), history.RequestID, model.Rejected); err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	if history.ToStatus == model.Submitted {
+		if _, err = tx.ExecContext(ctx, ResetMaterialReviewQuery, history.RequestID, model.Rejected); err != nil {
+			return errors.New(errors.RunQueryFailure).Wrap(err)
+		}
+	}
+
+	if _, err = tx.ExecContext(ctx, CreateRequestHistoryQuery, history.ID, history.RequestID, history.FromStatus, history.ToStatus, history.Actor.ID, history.Reason); err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.New(errors.CommittingTransactionFailure).Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateComment(ctx context.Context, comment model.Comment) *errors.Error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelDefault})
+	if err != nil {
+		return errors.New(errors.StartingTransactionFailure).Wrap(err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, CreateCommentQuery, comment.ID, comment.RequestID, comment.MaterialID, comment.ParentID, comment.Content, comment.CreatedBy.ID); err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	if errMention := r.createCommentMentions(ctx, tx, comment); errMention != nil {
+		return errMention
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.New(errors.CommittingTransactionFailure).Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) createCommentMentions(ctx context.Context, tx *sql.Tx, comment model.Comment) *errors.Error {
+	if len(comment.Mentions) == 0 {
+		return nil
+	}
+
+	stmt, err := tx.PrepareContext(ctx, CreateCommentMentionQuery)
+	if err != nil {
+		return errors.New(errors.PrepareStatementFailure).Wrap(err)
+	}
+	defer stmt.Close()
+
+	for i := range comment.Mentions {
+		if _, err = stmt.ExecContext(ctx, comment.ID, comment.Mentions[i]); err != nil {
+			return errors.New(errors.RunQueryFailure).Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) ListComments(ctx context.Context, requestID model.UUID, materialID *model.UUID) (model.Comments, *errors.Error) {
+	comments := make(model.Comments, 0)
+	err := r.db.QueryRowContext(ctx, ListCommentsQuery, requestID, materialID, materialID).Scan(&comments)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return comments, nil
+}
+
+func (r *Repository) GetComment(ctx context.Context, ID model.UUID) (*model.Comment, *errors.Error) {
+	comment := new(model.Comment)
+	err := r.db.QueryRowContext(ctx, GetCommentQuery, ID).Scan(&comment)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.New(errors.CommentNotFound)
+		}
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return comment, nil
+}
+
+func (r *Repository) UpdateComment(ctx context.Context, comment model.Comment) *errors.Error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelDefault})
+	if err != nil {
+		return errors.New(errors.StartingTransactionFailure).Wrap(err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, UpdateCommentQuery, comment.Content, comment.ID)
+	if err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	row, err := res.RowsAffected()
+	if err != nil {
+		return errors.New(errors.RowsAffectedFailure).Wrap(err)
+	}
+
+	if row < 1 {
+		return errors.New(errors.CommentNotFound)
+	}
+
+	if _, err = tx.ExecContext(ctx, DeleteCommentMentionsQuery, comment.ID); err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	if errMention := r.createCommentMentions(ctx, tx, comment); errMention != nil {
+		return errMention
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.New(errors.CommittingTransactionFailure).Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteComment(ctx context.Context, ID model.UUID) *errors.Error {
+	res, err := r.db.ExecContext(ctx, DeleteCommentQuery, ID, ID)
+	if err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	row, err := res.RowsAffected()
+	if err != nil {
+		return errors.New(errors.RowsAffectedFailure).Wrap(err)
+	}
+
+	if row < 1 {
+		return errors.New(errors.CommentNotFound)
+	}
+
+	return nil
+}
+
+func (r *Repository) ListCommentRecipients(ctx context.Context, comment model.Comment) ([]model.User, *errors.Error) {
+	parentID := comment.ParentID
+	if parentID == nil {
+		parentID = &comment.ID
+	}
+
+	rows, err := r.db.QueryContext(ctx, ListCommentRecipientsQuery, comment.CreatedBy.ID, comment.RequestID, parentID, comment.ID)
+	if err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	defer rows.Close()
+
+	users := make([]model.User, 0, 5)
+	for rows.Next() {
+		user := model.User{}
+		if err = rows.Scan(&user.ID, &user.Name, &user.Email); err != nil {
+			return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+		}
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+	}
+
+	return users, nil
+}
+
+func (r *Repository) ListUsersByRole(ctx context.Context, role model.Role) ([]model.User, *errors.Error) {
+	rows, err := r.db.QueryContext(ctx, ListUsersByRoleQuery, role)
+	if err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	defer rows.Close()
+
+	users := make([]model.User, 0, 5)
+	for rows.Next() {
+		user := model.User{Role: role}
+		if err = rows.Scan(&user.ID, &user.Name, &user.Email); err != nil {
+			return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+		}
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+	}
+
+	return users, nil
+}
+
+func (r *Repository) UpdateRequestDueAt(ctx context.Context, ID model.UUID, status model.Status, dueAt *int64) *errors.Error {
+	if _, err := r.db.ExecContext(ctx, UpdateRequestDueAtQuery, dueAt, ID, status); err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ListOverdueRequests(ctx context.Context, now int64) ([]model.Request, *errors.Error) {
+	rows, err := r.db.QueryContext(ctx, ListOverdueRequestsQuery, now)
+	if err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	defer rows.Close()
+
+	requests := make([]model.Request, 0, 5)
+	for rows.Next() {
+		request := model.Request{}
+		if err = rows.Scan(&request); err != nil {
+			return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+		}
+		requests = append(requests, request)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+	}
+
+	return requests, nil
+}
+
+func (r *Repository) MarkRequestEscalated(ctx context.Context, ID model.UUID, dueAt int64) *errors.Error {
+	if _, err := r.db.ExecContext(ctx, MarkRequestEscalatedQuery, ID, dueAt); err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *Repository) AssignMaterialNumber(ctx context.Context, ID model.UUID, number string) *errors.Error {
+	res, err := r.db.ExecContext(ctx, AssignMaterialNumberQuery, number, ID, model.Approved)
+	if err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	row, err := res.RowsAffected()
+	if err != nil {
+		return errors.New(errors.RowsAffectedFailure).Wrap(err)
+	}
+
+	if row < 1 {
+		return errors.New(errors.MaterialNotFound)
+	}
+
+	return nil
+}
+
+func (r *Repository) PublishMaterial(ctx context.Context, ID model.UUID, number string) *errors.Error {
+	res, err := r.db.ExecContext(ctx, PublishMaterialQuery, number, model.Published, ID, model.Approved)
+	if err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	row, err := res.RowsAffected()
+	if err != nil {
+		return errors.New(errors.RowsAffectedFailure).Wrap(err)
+	}
+
+	if row < 1 {
+		return errors.New(errors.MaterialNotFound)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetMaxMaterialNumber(ctx context.Context, from int64, to int64) (int64, *errors.Error) {
+	var number int64
+	if err := r.db.QueryRowContext(ctx, GetMaxMaterialNumberQuery, from, to).Scan(&number); err != nil {
+		return 0, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return number, nil
+}
+
+func (r *Repository) ListDuplicateCandidates(ctx context.Context, requestID model.UUID, filters []model.DuplicateFilter) ([]model.Material, *errors.Error) {
+	if len(filters) == 0 {
+		return []model.Material{}, nil
+	}
+
+	args := []any{requestID, model.Published, model.Submitted, model.Processed, model.Approved, model.PartiallyApproved, model.Rejected}
+	conditions := make([]string, 0, len(filters))
+	for i := range filters {
+		if len(filters[i].ShortTextPrefix) == 0 {
+			conditions = append(conditions, "(m.plant_code = ? AND m.type_code = ?)")
+			args = append(args, filters[i].PlantCode, filters[i].TypeCode)
+			continue
+		}
+		conditions = append(conditions, "(m.plant_code = ? AND m.type_code = ? AND m.short_text LIKE ?)")
+		args = append(args, filters[i].PlantCode, filters[i].TypeCode, filters[i].ShortTextPrefix+"%")
+	}
+	query := fmt.Sprintf("%s(%s)", ListDuplicateCandidatesQuery, strings.Join(conditions, " OR "))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	defer rows.Close()
+
+	materials := make([]model.Material, 0, 100)
+	for rows.Next() {
+		material := model.Material{}
+		var manufacturerCode *string
+		if err = rows.Scan(&material.ID, &material.Number, &material.Plant.Code, &material.Type.Code, &manufacturerCode, &material.PartNumber, &material.ShortText, &material.LongText, &material.Status, &material.RequestID); err != nil {
+			return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+		}
+		if manufacturerCode != nil {
+			material.Manufacturer = &model.Manufacturer{Code: *manufacturerCode}
+		}
+		materials = append(materials, material)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+	}
+
+	return materials, nil
+}
+
+func (r *Repository) MarkMaterialDuplicate(ctx context.Context, requestID model.UUID, materialID model.UUID, number string) *errors.Error {
+	var exists int
+	if err := r.db.QueryRowContext(ctx, GetPublishedMaterialQuery, number, model.Published).Scan(&exists); err != nil {
+		if err == sql.ErrNoRows {
+			return errors.New(errors.MaterialNumberNotFound)
+		}
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	res, err := r.db.ExecContext(ctx, MarkMaterialDuplicateQuery, number, materialID, requestID)
+	if err != nil {
+		return errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	row, err := res.RowsAffected()
+	if err != nil {
+		return errors.New(errors.RowsAffectedFailure).Wrap(err)
+	}
+
+	if row < 1 {
+		return errors.New(errors.MaterialNotFound)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetPublishedMaterialID(ctx context.Context, number string, plantCode string) (*model.UUID, *errors.Error) {
+	ID := new(model.UUID)
+	if err := r.db.QueryRowContext(ctx, GetPublishedMaterialIDQuery, number, model.Published, plantCode).Scan(ID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.New(errors.MaterialNumberNotFound)
+		}
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+
+	return ID, nil
+}
+
+func (r *Repository) ListPublishedMaterials(ctx context.Context, IDs []model.UUID) ([]model.Material, *errors.Error) {
+	if len(IDs) == 0 {
+		return []model.Material{}, nil
+	}
+
+	args := []any{model.Published}
+	for i := range IDs {
+		args = append(args, IDs[i])
+	}
+	query := fmt.Sprintf("%s(%s)", ListPublishedMaterialsQuery, strings.TrimSuffix(strings.Repeat("?, ", len(IDs)), ", "))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(errors.RunQueryFailure).Wrap(err)
+	}
+	defer rows.Close()
+
+	materials := make([]model.Material, 0, len(IDs))
+	for rows.Next() {
+		material := model.Material{}
+		var manufacturerCode *string
+		if err = rows.Scan(&material.ID, &material.Number, &material.Plant.Code, &material.Type.Code, &material.UoM.Code, &material.Group.Code, &manufacturerCode, &material.EquipmentCode, &material.ShortText, &material.LongText); err != nil {
+			return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+		}
+		if manufacturerCode != nil {
+			material.Manufacturer = &model.Manufacturer{Code: *manufacturerCode}
+		}
+		materials = append(materials, material)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.New(errors.ScanRowsFailure).Wrap(err)
+	}
+
+	return materials, nil
+}
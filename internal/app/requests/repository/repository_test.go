@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dev-pt-bai/cataloging/internal/model"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/database/query"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+)
+
+func TestGetRequestQueryKeepsMaterialsWithoutAttachments(t *testing.T) {
+	if !strings.Contains(GetRequestQuery, query.MaterialRecord) {
+		t.Errorf("GetRequestQuery does not use the shared material record")
+	}
+
+	if !strings.Contains(query.MaterialRecord, "'attachments', COALESCE(") {
+		t.Errorf("material record does not default attachments to an empty array")
+	}
+}
+
+func TestGetRequestQuerySeparatesCTEs(t *testing.T) {
+	lines := strings.Split(strings.TrimSpace(GetRequestQuery), "\n")
+	for i := 1; i < len(lines)-1; i++ {
+		line := strings.TrimSpace(lines[i])
+		if !strings.HasPrefix(line, "cte") || !strings.HasPrefix(strings.TrimSpace(lines[i+1]), "cte") {
+			continue
+		}
+		if !strings.HasSuffix(line, ",") {
+			t.Errorf("CTE on line %d is not followed by a comma", i+1)
+		}
+	}
+}
+
+func TestCheckMaterialCombinationQueryIsClosedByDefault(t *testing.T) {
+	if strings.Contains(CheckMaterialCombinationQuery, "NOT EXISTS") {
+		t.Errorf("CheckMaterialCombinationQuery allows plants and material types without links")
+	}
+
+	if got := strings.Count(CheckMaterialCombinationQuery, "?"); got != 4 {
+		t.Errorf("want: 4, got: %v", got)
+	}
+
+	for _, join := range []string{"p.deleted_at = 0", "mt.deleted_at = 0", "mg.deleted_at = 0"} {
+		if !strings.Contains(CheckMaterialCombinationQuery, join) {
+			t.Errorf("CheckMaterialCombinationQuery does not filter deleted master data on %s", join)
+		}
+	}
+}
+
+func TestMaterialCombinationError(t *testing.T) {
+	m := model.Material{Plant: model.Plant{Code: "1000"}, Type: model.MaterialType{Code: "ERSA"}, Group: model.MaterialGroup{Code: "G001"}}
+
+	tests := []struct {
+		name         string
+		typeAllowed  bool
+		groupAllowed bool
+		want         errors.ErrorCode
+	}{
+		{name: "allowed", typeAllowed: true, groupAllowed: true},
+		{name: "type not linked to plant", typeAllowed: false, groupAllowed: true, want: errors.MaterialTypeIsNotAllowed},
+		{name: "group not linked to type", typeAllowed: true, groupAllowed: false, want: errors.MaterialGroupIsNotAllowed},
+		{name: "nothing linked", typeAllowed: false, groupAllowed: false, want: errors.MaterialTypeIsNotAllowed},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := materialCombinationError(m, test.typeAllowed, test.groupAllowed)
+			if len(test.want) == 0 {
+				if err != nil {
+					t.Errorf("want: nil, got: %v", err)
+				}
+				return
+			}
+			if !err.ContainsCodes(test.want) {
+				t.Errorf("want: %v, got: %v", test.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,551 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service.go
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	model "github.com/dev-pt-bai/cataloging/internal/model"
+	manager "github.com/dev-pt-bai/cataloging/internal/pkg/async/manager"
+	errors "github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AssignMaterialNumber mocks base method.
+func (m *MockRepository) AssignMaterialNumber(ctx context.Context, ID model.UUID, number string) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignMaterialNumber", ctx, ID, number)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// AssignMaterialNumber indicates an expected call of AssignMaterialNumber.
+func (mr *MockRepositoryMockRecorder) AssignMaterialNumber(ctx, ID, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignMaterialNumber", reflect.TypeOf((*MockRepository)(nil).AssignMaterialNumber), ctx, ID, number)
+}
+
+// AssignRequest mocks base method.
+func (m *MockRepository) AssignRequest(ctx context.Context, ID model.UUID, assignee string) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignRequest", ctx, ID, assignee)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// AssignRequest indicates an expected call of AssignRequest.
+func (mr *MockRepositoryMockRecorder) AssignRequest(ctx, ID, assignee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRequest", reflect.TypeOf((*MockRepository)(nil).AssignRequest), ctx, ID, assignee)
+}
+
+// ClaimRequest mocks base method.
+func (m *MockRepository) ClaimRequest(ctx context.Context, assignee string) (*model.UUID, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimRequest", ctx, assignee)
+	ret0, _ := ret[0].(*model.UUID)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ClaimRequest indicates an expected call of ClaimRequest.
+func (mr *MockRepositoryMockRecorder) ClaimRequest(ctx, assignee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimRequest", reflect.TypeOf((*MockRepository)(nil).ClaimRequest), ctx, assignee)
+}
+
+// CreateComment mocks base method.
+func (m *MockRepository) CreateComment(ctx context.Context, comment model.Comment) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComment", ctx, comment)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// CreateComment indicates an expected call of CreateComment.
+func (mr *MockRepositoryMockRecorder) CreateComment(ctx, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockRepository)(nil).CreateComment), ctx, comment)
+}
+
+// CreateRequest mocks base method.
+func (m *MockRepository) CreateRequest(ctx context.Context, request model.Request) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRequest", ctx, request)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// CreateRequest indicates an expected call of CreateRequest.
+func (mr *MockRepositoryMockRecorder) CreateRequest(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequest", reflect.TypeOf((*MockRepository)(nil).CreateRequest), ctx, request)
+}
+
+// DeleteComment mocks base method.
+func (m *MockRepository) DeleteComment(ctx context.Context, ID model.UUID) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, ID)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockRepositoryMockRecorder) DeleteComment(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockRepository)(nil).DeleteComment), ctx, ID)
+}
+
+// DeleteRequest mocks base method.
+func (m *MockRepository) DeleteRequest(ctx context.Context, ID model.UUID) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRequest", ctx, ID)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// DeleteRequest indicates an expected call of DeleteRequest.
+func (mr *MockRepositoryMockRecorder) DeleteRequest(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRequest", reflect.TypeOf((*MockRepository)(nil).DeleteRequest), ctx, ID)
+}
+
+// GetComment mocks base method.
+func (m *MockRepository) GetComment(ctx context.Context, ID model.UUID) (*model.Comment, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComment", ctx, ID)
+	ret0, _ := ret[0].(*model.Comment)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// GetComment indicates an expected call of GetComment.
+func (mr *MockRepositoryMockRecorder) GetComment(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComment", reflect.TypeOf((*MockRepository)(nil).GetComment), ctx, ID)
+}
+
+// GetPublishedMaterialID mocks base method.
+func (m *MockRepository) GetPublishedMaterialID(ctx context.Context, number, plantCode string) (*model.UUID, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublishedMaterialID", ctx, number, plantCode)
+	ret0, _ := ret[0].(*model.UUID)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// GetPublishedMaterialID indicates an expected call of GetPublishedMaterialID.
+func (mr *MockRepositoryMockRecorder) GetPublishedMaterialID(ctx, number, plantCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublishedMaterialID", reflect.TypeOf((*MockRepository)(nil).GetPublishedMaterialID), ctx, number, plantCode)
+}
+
+// GetRequest mocks base method.
+func (m *MockRepository) GetRequest(ctx context.Context, ID model.UUID) (*model.Request, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequest", ctx, ID)
+	ret0, _ := ret[0].(*model.Request)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// GetRequest indicates an expected call of GetRequest.
+func (mr *MockRepositoryMockRecorder) GetRequest(ctx, ID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequest", reflect.TypeOf((*MockRepository)(nil).GetRequest), ctx, ID)
+}
+
+// ListCommentRecipients mocks base method.
+func (m *MockRepository) ListCommentRecipients(ctx context.Context, comment model.Comment) ([]model.User, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommentRecipients", ctx, comment)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListCommentRecipients indicates an expected call of ListCommentRecipients.
+func (mr *MockRepositoryMockRecorder) ListCommentRecipients(ctx, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommentRecipients", reflect.TypeOf((*MockRepository)(nil).ListCommentRecipients), ctx, comment)
+}
+
+// ListComments mocks base method.
+func (m *MockRepository) ListComments(ctx context.Context, requestID model.UUID, materialID *model.UUID) (model.Comments, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComments", ctx, requestID, materialID)
+	ret0, _ := ret[0].(model.Comments)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListComments indicates an expected call of ListComments.
+func (mr *MockRepositoryMockRecorder) ListComments(ctx, requestID, materialID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComments", reflect.TypeOf((*MockRepository)(nil).ListComments), ctx, requestID, materialID)
+}
+
+// ListDuplicateCandidates mocks base method.
+func (m *MockRepository) ListDuplicateCandidates(ctx context.Context, requestID model.UUID, filters []model.DuplicateFilter) ([]model.Material, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDuplicateCandidates", ctx, requestID, filters)
+	ret0, _ := ret[0].([]model.Material)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListDuplicateCandidates indicates an expected call of ListDuplicateCandidates.
+func (mr *MockRepositoryMockRecorder) ListDuplicateCandidates(ctx, requestID, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDuplicateCandidates", reflect.TypeOf((*MockRepository)(nil).ListDuplicateCandidates), ctx, requestID, filters)
+}
+
+// ListOverdueRequests mocks base method.
+func (m *MockRepository) ListOverdueRequests(ctx context.Context, now int64) ([]model.Request, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverdueRequests", ctx, now)
+	ret0, _ := ret[0].([]model.Request)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListOverdueRequests indicates an expected call of ListOverdueRequests.
+func (mr *MockRepositoryMockRecorder) ListOverdueRequests(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdueRequests", reflect.TypeOf((*MockRepository)(nil).ListOverdueRequests), ctx, now)
+}
+
+// ListPublishedMaterials mocks base method.
+func (m *MockRepository) ListPublishedMaterials(ctx context.Context, IDs []model.UUID) ([]model.Material, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublishedMaterials", ctx, IDs)
+	ret0, _ := ret[0].([]model.Material)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListPublishedMaterials indicates an expected call of ListPublishedMaterials.
+func (mr *MockRepositoryMockRecorder) ListPublishedMaterials(ctx, IDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublishedMaterials", reflect.TypeOf((*MockRepository)(nil).ListPublishedMaterials), ctx, IDs)
+}
+
+// ListRequests mocks base method.
+func (m *MockRepository) ListRequests(ctx context.Context, criteria model.ListRequestsCriteria) (*model.Requests, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRequests", ctx, criteria)
+	ret0, _ := ret[0].(*model.Requests)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListRequests indicates an expected call of ListRequests.
+func (mr *MockRepositoryMockRecorder) ListRequests(ctx, criteria interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRequests", reflect.TypeOf((*MockRepository)(nil).ListRequests), ctx, criteria)
+}
+
+// ListUsersByRole mocks base method.
+func (m *MockRepository) ListUsersByRole(ctx context.Context, role model.Role) ([]model.User, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsersByRole", ctx, role)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// ListUsersByRole indicates an expected call of ListUsersByRole.
+func (mr *MockRepositoryMockRecorder) ListUsersByRole(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersByRole", reflect.TypeOf((*MockRepository)(nil).ListUsersByRole), ctx, role)
+}
+
+// MarkMaterialDuplicate mocks base method.
+func (m *MockRepository) MarkMaterialDuplicate(ctx context.Context, requestID, materialID model.UUID, number string) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkMaterialDuplicate", ctx, requestID, materialID, number)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// MarkMaterialDuplicate indicates an expected call of MarkMaterialDuplicate.
+func (mr *MockRepositoryMockRecorder) MarkMaterialDuplicate(ctx, requestID, materialID, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkMaterialDuplicate", reflect.TypeOf((*MockRepository)(nil).MarkMaterialDuplicate), ctx, requestID, materialID, number)
+}
+
+// MarkRequestEscalated mocks base method.
+func (m *MockRepository) MarkRequestEscalated(ctx context.Context, ID model.UUID, dueAt int64) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRequestEscalated", ctx, ID, dueAt)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// MarkRequestEscalated indicates an expected call of MarkRequestEscalated.
+func (mr *MockRepositoryMockRecorder) MarkRequestEscalated(ctx, ID, dueAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRequestEscalated", reflect.TypeOf((*MockRepository)(nil).MarkRequestEscalated), ctx, ID, dueAt)
+}
+
+// PublishMaterial mocks base method.
+func (m *MockRepository) PublishMaterial(ctx context.Context, ID model.UUID, number string) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishMaterial", ctx, ID, number)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// PublishMaterial indicates an expected call of PublishMaterial.
+func (mr *MockRepositoryMockRecorder) PublishMaterial(ctx, ID, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMaterial", reflect.TypeOf((*MockRepository)(nil).PublishMaterial), ctx, ID, number)
+}
+
+// ReviewMaterial mocks base method.
+func (m *MockRepository) ReviewMaterial(ctx context.Context, requestID model.UUID, stage model.Status, review model.MaterialReview) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewMaterial", ctx, requestID, stage, review)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// ReviewMaterial indicates an expected call of ReviewMaterial.
+func (mr *MockRepositoryMockRecorder) ReviewMaterial(ctx, requestID, stage, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewMaterial", reflect.TypeOf((*MockRepository)(nil).ReviewMaterial), ctx, requestID, stage, review)
+}
+
+// UpdateComment mocks base method.
+func (m *MockRepository) UpdateComment(ctx context.Context, comment model.Comment) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateComment", ctx, comment)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// UpdateComment indicates an expected call of UpdateComment.
+func (mr *MockRepositoryMockRecorder) UpdateComment(ctx, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockRepository)(nil).UpdateComment), ctx, comment)
+}
+
+// UpdateRequest mocks base method.
+func (m *MockRepository) UpdateRequest(ctx context.Context, request model.Request, existingMaterialIDs map[model.UUID]struct{}) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRequest", ctx, request, existingMaterialIDs)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// UpdateRequest indicates an expected call of UpdateRequest.
+func (mr *MockRepositoryMockRecorder) UpdateRequest(ctx, request, existingMaterialIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRequest", reflect.TypeOf((*MockRepository)(nil).UpdateRequest), ctx, request, existingMaterialIDs)
+}
+
+// UpdateRequestDueAt mocks base method.
+func (m *MockRepository) UpdateRequestDueAt(ctx context.Context, ID model.UUID, status model.Status, dueAt *int64) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRequestDueAt", ctx, ID, status, dueAt)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// UpdateRequestDueAt indicates an expected call of UpdateRequestDueAt.
+func (mr *MockRepositoryMockRecorder) UpdateRequestDueAt(ctx, ID, status, dueAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRequestDueAt", reflect.TypeOf((*MockRepository)(nil).UpdateRequestDueAt), ctx, ID, status, dueAt)
+}
+
+// UpdateRequestStatus mocks base method.
+func (m *MockRepository) UpdateRequestStatus(ctx context.Context, history model.RequestHistory) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRequestStatus", ctx, history)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// UpdateRequestStatus indicates an expected call of UpdateRequestStatus.
+func (mr *MockRepositoryMockRecorder) UpdateRequestStatus(ctx, history interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRequestStatus", reflect.TypeOf((*MockRepository)(nil).UpdateRequestStatus), ctx, history)
+}
+
+// MockTaskManager is a mock of TaskManager interface.
+type MockTaskManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskManagerMockRecorder
+}
+
+// MockTaskManagerMockRecorder is the mock recorder for MockTaskManager.
+type MockTaskManagerMockRecorder struct {
+	mock *MockTaskManager
+}
+
+// NewMockTaskManager creates a new mock instance.
+func NewMockTaskManager(ctrl *gomock.Controller) *MockTaskManager {
+	mock := &MockTaskManager{ctrl: ctrl}
+	mock.recorder = &MockTaskManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskManager) EXPECT() *MockTaskManagerMockRecorder {
+	return m.recorder
+}
+
+// Enqueue mocks base method.
+func (m *MockTaskManager) Enqueue(ctx context.Context, task *manager.Task) *errors.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, task)
+	ret0, _ := ret[0].(*errors.Error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockTaskManagerMockRecorder) Enqueue(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockTaskManager)(nil).Enqueue), ctx, task)
+}
+
+// MockTabularReader is a mock of TabularReader interface.
+type MockTabularReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockTabularReaderMockRecorder
+}
+
+// MockTabularReaderMockRecorder is the mock recorder for MockTabularReader.
+type MockTabularReaderMockRecorder struct {
+	mock *MockTabularReader
+}
+
+// NewMockTabularReader creates a new mock instance.
+func NewMockTabularReader(ctrl *gomock.Controller) *MockTabularReader {
+	mock := &MockTabularReader{ctrl: ctrl}
+	mock.recorder = &MockTabularReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTabularReader) EXPECT() *MockTabularReaderMockRecorder {
+	return m.recorder
+}
+
+// Open mocks base method.
+func (m *MockTabularReader) Open(r io.Reader, filename string) ([][]string, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", r, filename)
+	ret0, _ := ret[0].([][]string)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockTabularReaderMockRecorder) Open(r, filename interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockTabularReader)(nil).Open), r, filename)
+}
+
+// MockExcelWriter is a mock of ExcelWriter interface.
+type MockExcelWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockExcelWriterMockRecorder
+}
+
+// MockExcelWriterMockRecorder is the mock recorder for MockExcelWriter.
+type MockExcelWriterMockRecorder struct {
+	mock *MockExcelWriter
+}
+
+// NewMockExcelWriter creates a new mock instance.
+func NewMockExcelWriter(ctrl *gomock.Controller) *MockExcelWriter {
+	mock := &MockExcelWriter{ctrl: ctrl}
+	mock.recorder = &MockExcelWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExcelWriter) EXPECT() *MockExcelWriterMockRecorder {
+	return m.recorder
+}
+
+// Write mocks base method.
+func (m *MockExcelWriter) Write(sheetName string, header []string, rows [][]string) ([]byte, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", sheetName, header, rows)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockExcelWriterMockRecorder) Write(sheetName, header, rows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockExcelWriter)(nil).Write), sheetName, header, rows)
+}
+
+// MockPublisher is a mock of Publisher interface.
+type MockPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublisherMockRecorder
+}
+
+// MockPublisherMockRecorder is the mock recorder for MockPublisher.
+type MockPublisherMockRecorder struct {
+	mock *MockPublisher
+}
+
+// NewMockPublisher creates a new mock instance.
+func NewMockPublisher(ctrl *gomock.Controller) *MockPublisher {
+	mock := &MockPublisher{ctrl: ctrl}
+	mock.recorder = &MockPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublisher) EXPECT() *MockPublisherMockRecorder {
+	return m.recorder
+}
+
+// PublishMaterial mocks base method.
+func (m *MockPublisher) PublishMaterial(ctx context.Context, material model.Material) (string, *errors.Error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishMaterial", ctx, material)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*errors.Error)
+	return ret0, ret1
+}
+
+// PublishMaterial indicates an expected call of PublishMaterial.
+func (mr *MockPublisherMockRecorder) PublishMaterial(ctx, material interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMaterial", reflect.TypeOf((*MockPublisher)(nil).PublishMaterial), ctx, material)
+}
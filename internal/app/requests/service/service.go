@@ -2,32 +2,272 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime/multipart"
+	"slices"
+	"time"
 
+	"github.com/dev-pt-bai/cataloging/configs"
 	"github.com/dev-pt-bai/cataloging/internal/model"
 	"github.com/dev-pt-bai/cataloging/internal/pkg/async/manager"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/calendar"
 	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/idoc"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/tabular"
 )
 
+//go:generate mockgen -source=./service.go -destination=./mock.go -package=service
+
 type Repository interface {
 	CreateRequest(ctx context.Context, request model.Request) *errors.Error
 	GetRequest(ctx context.Context, ID model.UUID) (*model.Request, *errors.Error)
+	ListRequests(ctx context.Context, criteria model.ListRequestsCriteria) (*model.Requests, *errors.Error)
+	UpdateRequest(ctx context.Context, request model.Request, existingMaterialIDs map[model.UUID]struct{}) *errors.Error
+	DeleteRequest(ctx context.Context, ID model.UUID) *errors.Error
+	UpdateRequestStatus(ctx context.Context, history model.RequestHistory) *errors.Error
+	CreateComment(ctx context.Context, comment model.Comment) *errors.Error
+	ListComments(ctx context.Context, requestID model.UUID, materialID *model.UUID) (model.Comments, *errors.Error)
+	GetComment(ctx context.Context, ID model.UUID) (*model.Comment, *errors.Error)
+	UpdateComment(ctx context.Context, comment model.Comment) *errors.Error
+	DeleteComment(ctx context.Context, ID model.UUID) *errors.Error
+	ListCommentRecipients(ctx context.Context, comment model.Comment) ([]model.User, *errors.Error)
+	ReviewMaterial(ctx context.Context, requestID model.UUID, stage model.Status, review model.MaterialReview) *errors.Error
+	ListUsersByRole(ctx context.Context, role model.Role) ([]model.User, *errors.Error)
+	ClaimRequest(ctx context.Context, assignee string) (*model.UUID, *errors.Error)
+	AssignRequest(ctx context.Context, ID model.UUID, assignee string) *errors.Error
+	UpdateRequestDueAt(ctx context.Context, ID model.UUID, status model.Status, dueAt *int64) *errors.Error
+	ListOverdueRequests(ctx context.Context, now int64) ([]model.Request, *errors.Error)
+	MarkRequestEscalated(ctx context.Context, ID model.UUID, dueAt int64) *errors.Error
+	ListDuplicateCandidates(ctx context.Context, requestID model.UUID, filters []model.DuplicateFilter) ([]model.Material, *errors.Error)
+	MarkMaterialDuplicate(ctx context.Context, requestID model.UUID, materialID model.UUID, number string) *errors.Error
+	GetPublishedMaterialID(ctx context.Context, number string, plantCode string) (*model.UUID, *errors.Error)
+	ListPublishedMaterials(ctx context.Context, IDs []model.UUID) ([]model.Material, *errors.Error)
+	AssignMaterialNumber(ctx context.Context, ID model.UUID, number string) *errors.Error
+	PublishMaterial(ctx context.Context, ID model.UUID, number string) *errors.Error
 }
 
 type TaskManager interface {
 	Enqueue(ctx context.Context, task *manager.Task) *errors.Error
 }
 
+type TabularReader interface {
+	Open(r io.Reader, filename string) ([][]string, *errors.Error)
+}
+
+type ExcelWriter interface {
+	Write(sheetName string, header []string, rows [][]string) ([]byte, *errors.Error)
+}
+
+type Publisher interface {
+	PublishMaterial(ctx context.Context, material model.Material) (string, *errors.Error)
+}
+
 type Service struct {
-	repository  Repository
-	taskManager TaskManager
+	repository             Repository
+	taskManager            TaskManager
+	publisher              Publisher
+	tabularReader          TabularReader
+	excelWriter            ExcelWriter
+	appBaseURL             string
+	sendEmailTaskName      string
+	publishRequestTaskName string
+	calendar               *calendar.Calendar
+	slaWorkingDays         map[model.Status]int
+	idocBuilder            *idoc.Builder
+}
+
+func New(repository Repository, taskManager TaskManager, publisher Publisher, tabularReader TabularReader, excelWriter ExcelWriter, config *configs.Config) (*Service, error) {
+	s := new(Service)
+	s.repository = repository
+	s.taskManager = taskManager
+	s.publisher = publisher
+	s.tabularReader = tabularReader
+	s.excelWriter = excelWriter
+
+	if config == nil {
+		return nil, fmt.Errorf("missing config")
+	}
+	s.appBaseURL = config.App.BaseURL
+
+	if len(config.App.Async.TaskTypes.SendEmail) == 0 {
+		return nil, fmt.Errorf("missing send email task name")
+	}
+	s.sendEmailTaskName = config.App.Async.TaskTypes.SendEmail
+
+	if len(config.App.Async.TaskTypes.PublishRequest) == 0 {
+		return nil, fmt.Errorf("missing publish request task name")
+	}
+	s.publishRequestTaskName = config.App.Async.TaskTypes.PublishRequest
+
+	c, err := calendar.New(config.App.SLA.Holidays)
+	if err != nil {
+		return nil, err
+	}
+	s.calendar = c
+
+	s.slaWorkingDays = make(map[model.Status]int, len(config.App.SLA.WorkingDays))
+	for k, v := range config.App.SLA.WorkingDays {
+		status := model.StatusFromStr(k)
+		if status == 0 {
+			return nil, fmt.Errorf("unknown SLA status: %s", k)
+		}
+		if v < 1 {
+			return nil, fmt.Errorf("invalid SLA working days of status %s: %d", k, v)
+		}
+		s.slaWorkingDays[status] = v
+	}
+
+	if len(config.External.SAP.Client) != 0 {
+		b, err := idoc.NewBuilder(config)
+		if err != nil {
+			return nil, err
+		}
+		s.idocBuilder = b
+	}
+
+	return s, nil
+}
+
+func (s *Service) CreateRequest(ctx context.Context, r model.Request) ([]model.MaterialDuplicates, *errors.Error) {
+	if err := s.resolveRevisions(ctx, &r); err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.CreateRequest(ctx, r); err != nil {
+		return nil, err
+	}
+
+	duplicates, err := s.findDuplicates(ctx, r)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return []model.MaterialDuplicates{}, nil
+	}
+
+	return duplicates, nil
+}
+
+func (s *Service) BulkCreateRequest(ctx context.Context, file multipart.File, filename string, r model.BulkCreateRequestRequest, requestedBy *model.Auth) (*model.Request, []model.MaterialDuplicates, []model.SpreadsheetRowError, *errors.Error) {
+	res, err := s.tabularReader.Open(file, filename)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	materials, rowErrors, err := s.buildBulkMaterials(res, r.IsNew)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if len(rowErrors) > 0 {
+		return nil, nil, rowErrors, errors.New(errors.InvalidSpreadsheetRow)
+	}
+
+	req := model.UpsertRequestRequest{Subject: r.Subject, IsNew: &r.IsNew, Materials: materials}
+	request := req.Model(nil, model.Draft, requestedBy)
+	duplicates, err := s.CreateRequest(ctx, request)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return &request, duplicates, nil, nil
+}
+
+func (s *Service) buildBulkMaterials(src [][]string, isNew bool) ([]model.UpsertMaterialRequest, []model.SpreadsheetRowError, *errors.Error) {
+	if len(src) <= 1 {
+		return nil, nil, errors.New(errors.EmptySpreadsheet)
+	}
+
+	columns, err := tabular.NewColumns(src[0], model.MaterialSpreadsheetColumns)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	materials := make([]model.UpsertMaterialRequest, 0, len(src)-1)
+	rowErrors := make([]model.SpreadsheetRowError, 0, 5)
+	for i, row := range src[1:] {
+		if tabular.IsBlank(row) {
+			continue
+		}
+
+		m := model.UpsertMaterialRequest{
+			Number:        columns.Optional(row, "Number"),
+			Plant:         columns.Value(row, "Plant"),
+			Type:          columns.Value(row, "Type"),
+			UoM:           columns.Value(row, "UoM"),
+			Group:         columns.Value(row, "Group"),
+			Manufacturer:  columns.Optional(row, "Manufacturer"),
+			PartNumber:    columns.Optional(row, "Part Number"),
+			EquipmentCode: columns.Optional(row, "Equipment Code"),
+			ShortText:     columns.Optional(row, "Short Text"),
+			LongText:      columns.Value(row, "Long Text"),
+			Note:          columns.Optional(row, "Note"),
+		}
+
+		if err := m.ValidateBulk(isNew); err != nil {
+			rowErrors = append(rowErrors, model.SpreadsheetRowError{Row: i + 2, Message: err.Error()})
+			continue
+		}
+
+		materials = append(materials, m)
+	}
+
+	if len(materials) == 0 && len(rowErrors) == 0 {
+		return nil, nil, errors.New(errors.EmptySpreadsheet)
+	}
+
+	return materials, rowErrors, nil
+}
+
+func (s *Service) FindDuplicates(ctx context.Context, ID model.UUID, requestedBy *model.Auth) ([]model.MaterialDuplicates, *errors.Error) {
+	request, err := s.GetRequest(ctx, ID, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.findDuplicates(ctx, *request)
 }
 
-func New(repository Repository, taskManager TaskManager) *Service {
-	return &Service{repository: repository, taskManager: taskManager}
+func (s *Service) findDuplicates(ctx context.Context, request model.Request) ([]model.MaterialDuplicates, *errors.Error) {
+	filters := make([]model.DuplicateFilter, 0, len(request.Materials))
+	for i := range request.Materials {
+		if f := request.Materials[i].DuplicateFilter(); !slices.Contains(filters, f) {
+			filters = append(filters, f)
+		}
+	}
+
+	candidates, err := s.repository.ListDuplicateCandidates(ctx, request.ID, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.FindDuplicates(request.Materials, candidates), nil
 }
 
-func (s *Service) CreateRequest(ctx context.Context, r model.Request) *errors.Error {
-	return s.repository.CreateRequest(ctx, r)
+func (s *Service) MarkMaterialDuplicate(ctx context.Context, requestID model.UUID, materialID model.UUID, number string, markedBy *model.Auth) *errors.Error {
+	request, err := s.GetRequest(ctx, requestID, markedBy)
+	if err != nil {
+		return err
+	}
+
+	if markedBy.Role != model.Cataloger && markedBy.Role != model.Administrator {
+		return errors.New(errors.ResourceIsForbidden)
+	}
+
+	if !request.Status.IsReviewable() {
+		return errors.New(errors.RequestIsNotReviewable)
+	}
+
+	if request.RequiresAssignment(markedBy) && !request.IsAssignedTo(markedBy) {
+		return errors.New(errors.RequestIsAssignedToOthers)
+	}
+
+	if !slices.ContainsFunc(request.Materials, func(m model.Material) bool { return m.ID == materialID }) {
+		return errors.New(errors.MaterialNotFound)
+	}
+
+	return s.repository.MarkMaterialDuplicate(ctx, requestID, materialID, number)
 }
 
 func (s *Service) GetRequest(ctx context.Context, ID model.UUID, requestedBy *model.Auth) (*model.Request, *errors.Error) {
@@ -36,9 +276,557 @@ func (s *Service) GetRequest(ctx context.Context, ID model.UUID, requestedBy *mo
 		return nil, err
 	}
 
-	if request.RequestedBy.ID != requestedBy.UserID && !requestedBy.IsAdmin() {
+	if !request.IsVisibleTo(requestedBy) {
 		return nil, errors.New(errors.ResourceIsForbidden)
 	}
 
 	return request, nil
 }
+
+func (s *Service) ListRequests(ctx context.Context, criteria model.ListRequestsCriteria, requestedBy *model.Auth) (*model.Requests, *errors.Error) {
+	if !requestedBy.IsReviewer() {
+		criteria.FilterRequest.RequestedBy = requestedBy.UserID
+	}
+
+	return s.repository.ListRequests(ctx, criteria)
+}
+
+func (s *Service) ListAssignedRequests(ctx context.Context, criteria model.ListRequestsCriteria, assignee *model.Auth) (*model.Requests, *errors.Error) {
+	criteria.FilterRequest.AssignedTo = assignee.UserID
+
+	return s.repository.ListRequests(ctx, criteria)
+}
+
+func (s *Service) ClaimRequest(ctx context.Context, assignee *model.Auth) (*model.Request, *errors.Error) {
+	if assignee.Role != model.Cataloger && !assignee.IsAdmin() {
+		return nil, errors.New(errors.ResourceIsForbidden)
+	}
+
+	ID, err := s.repository.ClaimRequest(ctx, assignee.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repository.GetRequest(ctx, *ID)
+}
+
+func (s *Service) AssignRequest(ctx context.Context, ID model.UUID, assignee string, assignedBy *model.Auth) *errors.Error {
+	if !assignedBy.IsAdmin() {
+		return errors.New(errors.ResourceIsForbidden)
+	}
+
+	return s.repository.AssignRequest(ctx, ID, assignee)
+}
+
+func (s *Service) UpdateRequest(ctx context.Context, r model.Request, requestedBy *model.Auth) *errors.Error {
+	request, err := s.repository.GetRequest(ctx, r.ID)
+	if err != nil {
+		return err
+	}
+
+	if request.RequestedBy.ID != requestedBy.UserID {
+		return errors.New(errors.ResourceIsForbidden)
+	}
+
+	if request.Status != model.Draft {
+		return errors.New(errors.RequestIsNotEditable)
+	}
+
+	existingMaterialIDs := make(map[model.UUID]struct{}, len(request.Materials))
+	for i := range request.Materials {
+		existingMaterialIDs[request.Materials[i].ID] = struct{}{}
+	}
+
+	if err = s.resolveRevisions(ctx, &r); err != nil {
+		return err
+	}
+
+	return s.repository.UpdateRequest(ctx, r, existingMaterialIDs)
+}
+
+func (s *Service) resolveRevisions(ctx context.Context, r *model.Request) *errors.Error {
+	if r.IsNew {
+		return nil
+	}
+
+	for i := range r.Materials {
+		if r.Materials[i].Number == nil {
+			return errors.New(errors.MaterialNumberNotFound)
+		}
+
+		ID, err := s.repository.GetPublishedMaterialID(ctx, *r.Materials[i].Number, r.Materials[i].Plant.Code)
+		if err != nil {
+			return err
+		}
+		r.Materials[i].RevisionOf = ID
+	}
+
+	return nil
+}
+
+func (s *Service) GetRequestDiff(ctx context.Context, ID model.UUID, requestedBy *model.Auth) ([]model.MaterialDiff, *errors.Error) {
+	request, err := s.GetRequest(ctx, ID, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	IDs := make([]model.UUID, 0, len(request.Materials))
+	for i := range request.Materials {
+		if request.Materials[i].RevisionOf != nil {
+			IDs = append(IDs, *request.Materials[i].RevisionOf)
+		}
+	}
+
+	published, err := s.repository.ListPublishedMaterials(ctx, IDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[model.UUID]model.Material, len(published))
+	for i := range published {
+		byID[published[i].ID] = published[i]
+	}
+
+	diffs := make([]model.MaterialDiff, 0, len(request.Materials))
+	for i := range request.Materials {
+		if request.Materials[i].RevisionOf == nil {
+			continue
+		}
+		if p, exists := byID[*request.Materials[i].RevisionOf]; exists {
+			diffs = append(diffs, request.Materials[i].Diff(p))
+		}
+	}
+
+	return diffs, nil
+}
+
+func (s *Service) ExportIDoc(ctx context.Context, IDs []model.UUID, requestedBy *model.Auth) ([]byte, *errors.Error) {
+	if requestedBy.Role != model.Cataloger && requestedBy.Role != model.Administrator {
+		return nil, errors.New(errors.ResourceIsForbidden)
+	}
+
+	if s.idocBuilder == nil {
+		return nil, errors.New(errors.IDocExportIsNotConfigured)
+	}
+
+	materials := make([]model.Material, 0, len(IDs))
+	for i := range IDs {
+		if slices.Contains(IDs[:i], IDs[i]) {
+			continue
+		}
+
+		request, err := s.GetRequest(ctx, IDs[i], requestedBy)
+		if err != nil {
+			return nil, err
+		}
+
+		if !request.IsExportable() {
+			return nil, errors.New(errors.RequestIsNotApproved).Wrap(fmt.Errorf("request %s is not approved", request.ID))
+		}
+
+		materials = append(materials, request.ExportableMaterials()...)
+	}
+
+	data, err := s.idocBuilder.Build(materials, time.Now().In(calendar.WIB))
+	if err != nil {
+		return nil, errors.New(errors.BuildIDocFailure).Wrap(err)
+	}
+
+	return data, nil
+}
+
+func (s *Service) ExportRequest(ctx context.Context, ID model.UUID, requestedBy *model.Auth) ([]byte, *errors.Error) {
+	request, err := s.GetRequest(ctx, ID, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([][]string, 0, len(request.Materials))
+	for i := range request.Materials {
+		rows = append(rows, request.Materials[i].SpreadsheetRow())
+	}
+
+	return s.excelWriter.Write(request.Subject, model.MaterialSpreadsheetColumns, rows)
+}
+
+func (s *Service) DeleteRequest(ctx context.Context, ID model.UUID, requestedBy *model.Auth) *errors.Error {
+	request, err := s.repository.GetRequest(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	if request.RequestedBy.ID != requestedBy.UserID {
+		return errors.New(errors.ResourceIsForbidden)
+	}
+
+	if request.Status != model.Draft {
+		return errors.New(errors.RequestIsNotEditable)
+	}
+
+	return s.repository.DeleteRequest(ctx, ID)
+}
+
+func (s *Service) GetRequestHistory(ctx context.Context, ID model.UUID, requestedBy *model.Auth) ([]model.RequestHistory, *errors.Error) {
+	request, err := s.GetRequest(ctx, ID, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return request.History, nil
+}
+
+func (s *Service) TransitRequest(ctx context.Context, ID model.UUID, transition model.Transition, reason *string, actor *model.Auth) *errors.Error {
+	to, exists := transition.Target()
+	if !exists {
+		return errors.New(errors.InvalidRequestStatusTransition)
+	}
+
+	request, err := s.repository.GetRequest(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	if !request.IsVisibleTo(actor) {
+		return errors.New(errors.ResourceIsForbidden)
+	}
+
+	if !transition.IsAllowedFrom(request.Status) {
+		return errors.New(errors.InvalidRequestStatusTransition)
+	}
+
+	if !transition.IsPermitted(request.Status, actor, request.RequestedBy.ID) {
+		return errors.New(errors.RequestTransitionIsForbidden)
+	}
+
+	if request.RequiresAssignment(actor) && !request.IsAssignedTo(actor) {
+		return errors.New(errors.RequestIsAssignedToOthers)
+	}
+
+	if transition == model.Submit {
+		for i := range request.Materials {
+			if len(request.Materials[i].Attachments) == 0 {
+				return errors.New(errors.MissingMaterialAttachment).Wrap(fmt.Errorf("material %s has no attachment", request.Materials[i].ID))
+			}
+			if request.Materials[i].ShortText == nil {
+				continue
+			}
+			if err := model.ValidateShortText(*request.Materials[i].ShortText); err != nil {
+				return errors.New(errors.InvalidShortText).Wrap(fmt.Errorf("material %s: %w", request.Materials[i].ID, err))
+			}
+		}
+	}
+
+	if (transition == model.Process || transition == model.Approve) && slices.ContainsFunc(request.Materials, func(m model.Material) bool { return m.Review != nil && m.Review.Decision == model.ReturnMaterial }) {
+		return errors.New(errors.InvalidRequestStatusTransition).Wrap(fmt.Errorf("request %s has returned materials", ID))
+	}
+
+	if transition == model.Publish {
+		if s.publisher == nil {
+			return errors.New(errors.PublisherIsNotConfigured)
+		}
+		return s.taskManager.Enqueue(ctx, model.NewPublishRequestTask(ID, actor, reason).NewTask(s.publishRequestTaskName))
+	}
+
+	if to == model.Approved && slices.ContainsFunc(request.Materials, func(m model.Material) bool { return m.Status == model.Rejected }) {
+		to = model.PartiallyApproved
+	}
+
+	if err = s.repository.UpdateRequestStatus(ctx, model.NewRequestHistory(ID, &request.Status, to, actor, reason)); err != nil {
+		return err
+	}
+	s.handleStatusChange(ctx, ID, request.Status)
+
+	return nil
+}
+
+func (s *Service) PublishRequest(data json.RawMessage) error {
+	task := new(model.PublishRequestTask)
+	if err := json.Unmarshal(data, task); err != nil {
+		slog.Error("requests.Service.PublishRequest: failed to parse data", slog.String("cause", err.Error()))
+		return nil
+	}
+
+	ctx := context.Background()
+	request, err := s.repository.GetRequest(ctx, task.RequestID)
+	if err != nil {
+		if err.ContainsCodes(errors.RequestNotFound) {
+			slog.Error(err.Error(), slog.String("requestID", task.RequestID.String()))
+			return nil
+		}
+		return err
+	}
+
+	if !model.Publish.IsAllowedFrom(request.Status) {
+		return nil
+	}
+
+	if s.publisher == nil {
+		slog.Error(errors.New(errors.PublisherIsNotConfigured).Error(), slog.String("requestID", request.ID.String()))
+		return nil
+	}
+
+	materials := request.ExportableMaterials()
+	for i := range materials {
+		if errPublish := s.publishMaterial(ctx, materials[i]); errPublish != nil {
+			return errPublish
+		}
+	}
+
+	if err = s.repository.UpdateRequestStatus(ctx, model.NewRequestHistory(request.ID, &request.Status, model.Published, task.Actor(), task.Reason)); err != nil {
+		if err.ContainsCodes(errors.InvalidRequestStatusTransition) {
+			return nil
+		}
+		return err
+	}
+	s.handleStatusChange(ctx, request.ID, request.Status)
+
+	return nil
+}
+
+func (s *Service) publishMaterial(ctx context.Context, material model.Material) *errors.Error {
+	if material.RevisionOf == nil && material.Number != nil {
+		return s.repository.PublishMaterial(ctx, material.ID, *material.Number)
+	}
+
+	number, err := s.publisher.PublishMaterial(ctx, material)
+	if err != nil {
+		return err
+	}
+
+	if material.RevisionOf == nil {
+		if err = s.repository.AssignMaterialNumber(ctx, material.ID, number); err != nil {
+			return err
+		}
+	}
+
+	return s.repository.PublishMaterial(ctx, material.ID, number)
+}
+
+func (s *Service) ReviewMaterial(ctx context.Context, requestID model.UUID, review model.MaterialReview, reviewedBy *model.Auth) *errors.Error {
+	request, err := s.GetRequest(ctx, requestID, reviewedBy)
+	if err != nil {
+		return err
+	}
+
+	if !request.Status.IsReviewable() {
+		return errors.New(errors.RequestIsNotReviewable)
+	}
+
+	if !request.Status.CanBeReviewedBy(reviewedBy.Role) {
+		return errors.New(errors.ResourceIsForbidden)
+	}
+
+	if request.RequiresAssignment(reviewedBy) && !request.IsAssignedTo(reviewedBy) {
+		return errors.New(errors.RequestIsAssignedToOthers)
+	}
+
+	if !slices.ContainsFunc(request.Materials, func(m model.Material) bool { return m.ID == review.MaterialID }) {
+		return errors.New(errors.MaterialNotFound)
+	}
+
+	if err = s.repository.ReviewMaterial(ctx, requestID, request.Status, review); err != nil {
+		return err
+	}
+	s.handleStatusChange(ctx, requestID, request.Status)
+
+	return nil
+}
+
+func (s *Service) handleStatusChange(ctx context.Context, ID model.UUID, previous model.Status) {
+	request, err := s.repository.GetRequest(ctx, ID)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return
+	}
+
+	if request.Status == previous {
+		return
+	}
+
+	if err = s.repository.UpdateRequestDueAt(ctx, ID, request.Status, s.dueAt(request.Status, time.Now())); err != nil {
+		slog.ErrorContext(ctx, err.Error())
+	}
+
+	s.notifyRequestStatus(ctx, request)
+}
+
+func (s *Service) dueAt(status model.Status, from time.Time) *int64 {
+	days, exists := s.slaWorkingDays[status]
+	if !exists {
+		return nil
+	}
+
+	dueAt := s.calendar.AddWorkingDays(from, days).Unix()
+	return &dueAt
+}
+
+func (s *Service) notifyRequestStatus(ctx context.Context, request *model.Request) {
+	if !request.Status.IsNotified() {
+		return
+	}
+
+	recipients := []model.User{request.RequestedBy}
+	if role := request.Status.NotifiedRole(); role != 0 {
+		users, err := s.repository.ListUsersByRole(ctx, role)
+		if err != nil {
+			slog.ErrorContext(ctx, err.Error())
+		}
+		for i := range users {
+			if users[i].ID != request.RequestedBy.ID {
+				recipients = append(recipients, users[i])
+			}
+		}
+	}
+
+	for i := range recipients {
+		email := request.NewNotificationEmail(recipients[i], s.appBaseURL)
+		if err := s.taskManager.Enqueue(ctx, email.NewTask(s.sendEmailTaskName)); err != nil {
+			slog.ErrorContext(ctx, err.Error())
+		}
+	}
+}
+
+func (s *Service) EscalateOverdueRequests() error {
+	ctx := context.Background()
+
+	requests, err := s.repository.ListOverdueRequests(ctx, time.Now().Unix())
+	if err != nil {
+		return err
+	}
+
+	if len(requests) == 0 {
+		return nil
+	}
+
+	admins, err := s.repository.ListUsersByRole(ctx, model.Administrator)
+	if err != nil {
+		return err
+	}
+
+	for i := range requests {
+		recipients := slices.Clone(admins)
+		if assignee := requests[i].AssignedTo; assignee != nil && !slices.ContainsFunc(admins, func(u model.User) bool { return u.ID == assignee.ID }) {
+			recipients = append(recipients, *assignee)
+		}
+
+		if err = s.escalateRequest(ctx, requests[i], recipients); err != nil {
+			slog.ErrorContext(ctx, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) escalateRequest(ctx context.Context, request model.Request, recipients []model.User) *errors.Error {
+	for i := range recipients {
+		email := request.NewEscalationEmail(recipients[i], s.appBaseURL)
+		if err := s.taskManager.Enqueue(ctx, email.NewTask(s.sendEmailTaskName)); err != nil {
+			return err
+		}
+	}
+
+	return s.repository.MarkRequestEscalated(ctx, request.ID, *request.DueAt)
+}
+
+func (s *Service) CreateComment(ctx context.Context, c model.Comment, createdBy *model.Auth) *errors.Error {
+	request, err := s.GetRequest(ctx, c.RequestID, createdBy)
+	if err != nil {
+		return err
+	}
+
+	if c.ParentID != nil {
+		parent, err := s.repository.GetComment(ctx, *c.ParentID)
+		if err != nil {
+			return err
+		}
+
+		if parent.RequestID != c.RequestID {
+			return errors.New(errors.CommentNotFound)
+		}
+
+		if parent.ParentID != nil {
+			c.ParentID = parent.ParentID
+		}
+		c.MaterialID = parent.MaterialID
+	}
+
+	if c.MaterialID != nil && !slices.ContainsFunc(request.Materials, func(m model.Material) bool { return m.ID == *c.MaterialID }) {
+		return errors.New(errors.MaterialNotFound)
+	}
+
+	if err = s.repository.CreateComment(ctx, c); err != nil {
+		return err
+	}
+
+	comment, err := s.repository.GetComment(ctx, c.ID)
+	if err != nil {
+		return err
+	}
+
+	s.notifyComment(ctx, comment)
+
+	return nil
+}
+
+func (s *Service) notifyComment(ctx context.Context, comment *model.Comment) {
+	recipients, err := s.repository.ListCommentRecipients(ctx, *comment)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return
+	}
+
+	for i := range recipients {
+		if err = s.taskManager.Enqueue(ctx, comment.NewNotificationEmail(recipients[i], s.appBaseURL).NewTask(s.sendEmailTaskName)); err != nil {
+			slog.ErrorContext(ctx, err.Error())
+		}
+	}
+}
+
+func (s *Service) ListComments(ctx context.Context, requestID model.UUID, materialID *model.UUID, requestedBy *model.Auth) ([]*model.Comment, *errors.Error) {
+	if _, err := s.GetRequest(ctx, requestID, requestedBy); err != nil {
+		return nil, err
+	}
+
+	comments, err := s.repository.ListComments(ctx, requestID, materialID)
+	if err != nil {
+		return nil, err
+	}
+
+	return comments.Thread(), nil
+}
+
+func (s *Service) UpdateComment(ctx context.Context, c model.Comment, updatedBy *model.Auth) *errors.Error {
+	comment, err := s.getOwnComment(ctx, c.RequestID, c.ID, updatedBy)
+	if err != nil {
+		return err
+	}
+	comment.Content = c.Content
+	comment.Mentions = c.Mentions
+
+	return s.repository.UpdateComment(ctx, *comment)
+}
+
+func (s *Service) DeleteComment(ctx context.Context, requestID model.UUID, ID model.UUID, deletedBy *model.Auth) *errors.Error {
+	if _, err := s.getOwnComment(ctx, requestID, ID, deletedBy); err != nil {
+		return err
+	}
+
+	return s.repository.DeleteComment(ctx, ID)
+}
+
+func (s *Service) getOwnComment(ctx context.Context, requestID model.UUID, ID model.UUID, requestedBy *model.Auth) (*model.Comment, *errors.Error) {
+	comment, err := s.repository.GetComment(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.RequestID != requestID {
+		return nil, errors.New(errors.CommentNotFound)
+	}
+
+	if comment.CreatedBy.ID != requestedBy.UserID && !requestedBy.IsAdmin() {
+		return nil, errors.New(errors.ResourceIsForbidden)
+	}
+
+	return comment, nil
+}
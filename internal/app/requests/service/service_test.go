@@ -0,0 +1,365 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dev-pt-bai/cataloging/configs"
+	mservice "github.com/dev-pt-bai/cataloging/internal/app/materials/service"
+	"github.com/dev-pt-bai/cataloging/internal/model"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/excel"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/tabular"
+	"github.com/golang/mock/gomock"
+)
+
+func TestUpdateRequestKeepsMaterialsWithoutAttachments(t *testing.T) {
+	repository := NewMockRepository(gomock.NewController(t))
+	s := &Service{repository: repository}
+
+	ctx := context.Background()
+	auth := &model.Auth{UserID: "dummy-owner", Role: model.Requester}
+	materialID := model.NewUUID()
+
+	existing := &model.Request{
+		ID:          model.NewUUID(),
+		IsNew:       true,
+		RequestedBy: model.User{ID: auth.UserID},
+		Status:      model.Draft,
+		Materials:   []model.Material{{ID: materialID, Attachments: []model.Asset{}}},
+	}
+	update := model.Request{ID: existing.ID, IsNew: true, Materials: []model.Material{{ID: materialID}}}
+
+	repository.EXPECT().GetRequest(ctx, existing.ID).Return(existing, nil)
+	repository.EXPECT().UpdateRequest(ctx, update, map[model.UUID]struct{}{materialID: {}}).Return(nil)
+
+	if err := s.UpdateRequest(ctx, update, auth); err != nil {
+		t.Errorf("UpdateRequest() error = %v", err)
+	}
+}
+
+func TestCreateCommentIgnoresNotificationFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repository := NewMockRepository(ctrl)
+	taskManager := NewMockTaskManager(ctrl)
+	s := &Service{repository: repository, taskManager: taskManager, appBaseURL: "https://cataloging.bai.id", sendEmailTaskName: "send-email"}
+
+	ctx := context.Background()
+	auth := &model.Auth{UserID: "dummy-owner", Role: model.Requester}
+	request := &model.Request{ID: model.NewUUID(), RequestedBy: model.User{ID: auth.UserID}, Status: model.Submitted}
+	comment := model.Comment{ID: model.NewUUID(), RequestID: request.ID, Content: "please attach the datasheet", CreatedBy: model.User{ID: auth.UserID}}
+
+	repository.EXPECT().GetRequest(ctx, request.ID).Return(request, nil)
+	repository.EXPECT().CreateComment(ctx, comment).Return(nil)
+	repository.EXPECT().GetComment(ctx, comment.ID).Return(&comment, nil)
+	repository.EXPECT().ListCommentRecipients(ctx, comment).Return([]model.User{{ID: "dummy-cataloger", Email: "cataloger@bai.id"}}, nil)
+	taskManager.EXPECT().Enqueue(ctx, gomock.Any()).Return(errors.New(errors.RunQueryFailure))
+
+	if err := s.CreateComment(ctx, comment, auth); err != nil {
+		t.Errorf("CreateComment() error = %v", err)
+	}
+}
+
+func TestExportIDocWithoutSAPConfig(t *testing.T) {
+	config := new(configs.Config)
+	config.App.Async.TaskTypes.SendEmail = "send-email"
+	config.App.Async.TaskTypes.PublishRequest = "publish-request"
+
+	s, errNew := New(nil, nil, nil, nil, nil, config)
+	if errNew != nil {
+		t.Fatalf("New() error = %v", errNew)
+	}
+
+	auth := &model.Auth{UserID: "dummy-cataloger", Role: model.Cataloger}
+	if _, err := s.ExportIDoc(context.Background(), []model.UUID{model.NewUUID()}, auth); !err.ContainsCodes(errors.IDocExportIsNotConfigured) {
+		t.Errorf("want: %v, got: %v", errors.IDocExportIsNotConfigured, err)
+	}
+}
+
+func TestPublishRequestSkipsAssignedNumbers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repository := NewMockRepository(ctrl)
+	publisher := NewMockPublisher(ctrl)
+	s := &Service{repository: repository, publisher: publisher}
+
+	assigned := "10000001"
+	fresh := model.Material{ID: model.NewUUID(), Status: model.Approved}
+	retried := model.Material{ID: model.NewUUID(), Number: &assigned, Status: model.Approved}
+	published := model.Material{ID: model.NewUUID(), Number: &assigned, Status: model.Published}
+	request := &model.Request{ID: model.NewUUID(), IsNew: true, Status: model.Approved, Materials: []model.Material{fresh, retried, published}}
+
+	gomock.InOrder(
+		repository.EXPECT().GetRequest(gomock.Any(), request.ID).Return(request, nil),
+		publisher.EXPECT().PublishMaterial(gomock.Any(), fresh).Return("10000002", nil),
+		repository.EXPECT().AssignMaterialNumber(gomock.Any(), fresh.ID, "10000002").Return(nil),
+		repository.EXPECT().PublishMaterial(gomock.Any(), fresh.ID, "10000002").Return(nil),
+		repository.EXPECT().PublishMaterial(gomock.Any(), retried.ID, assigned).Return(nil),
+		repository.EXPECT().UpdateRequestStatus(gomock.Any(), gomock.Any()).Return(errors.New(errors.InvalidRequestStatusTransition)),
+	)
+
+	data, _ := json.Marshal(model.PublishRequestTask{RequestID: request.ID})
+	if err := s.PublishRequest(data); err != nil {
+		t.Errorf("PublishRequest() error = %v", err)
+	}
+}
+
+func TestTransitRequestPublishWithoutPublisher(t *testing.T) {
+	repository := NewMockRepository(gomock.NewController(t))
+	s := &Service{repository: repository}
+
+	auth := &model.Auth{UserID: "dummy-cataloger", Role: model.Cataloger}
+	request := &model.Request{ID: model.NewUUID(), IsNew: true, Status: model.Approved}
+
+	repository.EXPECT().GetRequest(gomock.Any(), request.ID).Return(request, nil)
+
+	if err := s.TransitRequest(context.Background(), request.ID, model.Publish, nil, auth); !err.ContainsCodes(errors.PublisherIsNotConfigured) {
+		t.Errorf("want: %v, got: %v", errors.PublisherIsNotConfigured, err)
+	}
+}
+
+func TestTransitRequestWithReturnedMaterials(t *testing.T) {
+	repository := NewMockRepository(gomock.NewController(t))
+	s := &Service{repository: repository}
+
+	tests := []struct {
+		name       string
+		status     model.Status
+		transition model.Transition
+		actor      *model.Auth
+	}{
+		{
+			name:       "process",
+			status:     model.Submitted,
+			transition: model.Process,
+			actor:      &model.Auth{UserID: "dummy-cataloger", Role: model.Cataloger},
+		},
+		{
+			name:       "approve",
+			status:     model.Processed,
+			transition: model.Approve,
+			actor:      &model.Auth{UserID: "dummy-approver", Role: model.Approver},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := &model.Request{
+				ID:         model.NewUUID(),
+				IsNew:      true,
+				Status:     test.status,
+				AssignedTo: &model.User{ID: test.actor.UserID},
+				Materials: []model.Material{
+					{ID: model.NewUUID(), Status: test.status, Review: &model.MaterialReview{Decision: model.AcceptMaterial}},
+					{ID: model.NewUUID(), Status: model.Draft, Review: &model.MaterialReview{Decision: model.ReturnMaterial}},
+					{ID: model.NewUUID(), Status: model.Rejected, Review: &model.MaterialReview{Decision: model.RejectMaterial}},
+				},
+			}
+
+			repository.EXPECT().GetRequest(gomock.Any(), request.ID).Return(request, nil)
+
+			if err := s.TransitRequest(context.Background(), request.ID, test.transition, nil, test.actor); !err.ContainsCodes(errors.InvalidRequestStatusTransition) {
+				t.Errorf("want: %v, got: %v", errors.InvalidRequestStatusTransition, err)
+			}
+		})
+	}
+}
+
+func TestBuildBulkMaterials(t *testing.T) {
+	header := []string{"Plant", "Type", "UoM", "Group", "Long Text", "Number"}
+
+	type result struct {
+		materials int
+		rowErrors []int
+		code      errors.ErrorCode
+	}
+
+	tests := []struct {
+		name  string
+		src   [][]string
+		isNew bool
+		want  result
+	}{
+		{
+			name:  "header only",
+			src:   [][]string{header},
+			isNew: true,
+			want:  result{code: errors.EmptySpreadsheet},
+		},
+		{
+			name:  "unknown column",
+			src:   [][]string{{"Plant", "Color"}, {"1000", "red"}},
+			isNew: true,
+			want:  result{code: errors.UnknownField},
+		},
+		{
+			name: "valid rows without attachments",
+			src: [][]string{
+				header,
+				{"1000", "ERSA", "PC", "G001", "BEARING, BALL; 6205-2RS", ""},
+				{"", "", "", "", "", ""},
+				{"1000", "ERSA", "PC", "G001", "GASKET, SPIRAL WOUND", ""},
+			},
+			isNew: true,
+			want:  result{materials: 2},
+		},
+		{
+			name: "invalid rows are reported by spreadsheet row",
+			src: [][]string{
+				header,
+				{"1000", "ERSA", "PC", "G001", "BEARING, BALL; 6205-2RS", ""},
+				{"1000", "", "PC", "G001", "GASKET, SPIRAL WOUND", ""},
+				{"1000", "ERSA", "PC", "G001", "VALVE, GATE", "10000001"},
+			},
+			isNew: true,
+			want:  result{materials: 1, rowErrors: []int{3, 4}},
+		},
+		{
+			name: "revision rows require a number",
+			src: [][]string{
+				header,
+				{"1000", "ERSA", "PC", "G001", "BEARING, BALL; 6205-2RS", "10000001"},
+				{"1000", "ERSA", "PC", "G001", "GASKET, SPIRAL WOUND", ""},
+			},
+			isNew: false,
+			want:  result{materials: 1, rowErrors: []int{3}},
+		},
+	}
+
+	s := new(Service)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			materials, rowErrors, err := s.buildBulkMaterials(test.src, test.isNew)
+			if test.want.code != "" || err != nil {
+				if !err.ContainsCodes(test.want.code) {
+					t.Errorf("want: %v, got: %v", test.want.code, err)
+				}
+				return
+			}
+
+			if len(materials) != test.want.materials {
+				t.Errorf("want: %v materials, got: %v", test.want.materials, len(materials))
+			}
+
+			rows := make([]int, 0, len(rowErrors))
+			for i := range rowErrors {
+				rows = append(rows, rowErrors[i].Row)
+			}
+			if len(rows) != len(test.want.rowErrors) || (len(rows) > 0 && !reflect.DeepEqual(rows, test.want.rowErrors)) {
+				t.Errorf("want: %v, got: %v", test.want.rowErrors, rows)
+			}
+		})
+	}
+}
+
+type masterDataRepository struct {
+	mservice.Repository
+}
+
+func (r masterDataRepository) ListAllPlants(ctx context.Context) ([]*model.Plant, *errors.Error) {
+	return []*model.Plant{{Code: "1000", Description: "Balikpapan"}}, nil
+}
+
+func (r masterDataRepository) ListAllMaterialTypes(ctx context.Context) ([]*model.MaterialType, *errors.Error) {
+	return []*model.MaterialType{{Code: "ERSA", Description: "Spare Parts"}}, nil
+}
+
+func (r masterDataRepository) ListAllMaterialUoMs(ctx context.Context) ([]*model.MaterialUoM, *errors.Error) {
+	return []*model.MaterialUoM{{Code: "PC", Description: "Piece"}}, nil
+}
+
+func (r masterDataRepository) ListAllMaterialGroups(ctx context.Context) ([]*model.MaterialGroup, *errors.Error) {
+	return []*model.MaterialGroup{{Code: "G001", Description: "Bearings"}}, nil
+}
+
+func (r masterDataRepository) ListAllManufacturers(ctx context.Context) ([]*model.Manufacturer, *errors.Error) {
+	return []*model.Manufacturer{{Code: "SKF", Description: "SKF Group"}}, nil
+}
+
+func appendTemplateRow(t *testing.T, template []byte, row []string) []byte {
+	zr, err := zip.NewReader(bytes.NewReader(template), int64(len(template)))
+	if err != nil {
+		t.Fatalf("zip.NewReader() error = %v", err)
+	}
+
+	cells := new(strings.Builder)
+	for i, value := range row {
+		if len(value) > 0 {
+			fmt.Fprintf(cells, `<c r="%c2" t="inlineStr"><is><t>%s</t></is></c>`, 'A'+i, value)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("Open() error = %v", err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("ReadAll() error = %v", err)
+		}
+		if f.Name == "xl/worksheets/sheet1.xml" {
+			content = bytes.Replace(content, []byte("</sheetData>"), []byte(`<row r="2">`+cells.String()+`</row></sheetData>`), 1)
+		}
+		w, err := zw.Create(f.Name)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		w.Write(content)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestBuildBulkMaterialsFromTemplate(t *testing.T) {
+	config := new(configs.Config)
+	config.App.Async.TaskTypes.ProcessImport = "processImport"
+	config.App.Spreadsheet.ImportDir = t.TempDir()
+	reader, errReader := tabular.NewReader(config)
+	if errReader != nil {
+		t.Fatalf("NewReader() error = %v", errReader)
+	}
+
+	materialService, errService := mservice.New(masterDataRepository{}, reader, excel.NewWriter(), nil, config)
+	if errService != nil {
+		t.Fatalf("New() error = %v", errService)
+	}
+
+	template, err := materialService.ExportRequestTemplate(context.Background())
+	if err != nil {
+		t.Fatalf("ExportRequestTemplate() error = %v", err)
+	}
+
+	filled := appendTemplateRow(t, template, []string{"", "1000", "ERSA", "PC", "G001", "SKF", "6205-2RS", "", "", "BEARING, BALL; 6205-2RS", ""})
+	src, err := reader.Open(bytes.NewReader(filled), "request_template.xlsx")
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	s := &Service{tabularReader: reader}
+	materials, rowErrors, err := s.buildBulkMaterials(src, true)
+	if err != nil {
+		t.Fatalf("buildBulkMaterials() error = %v", err)
+	}
+
+	if len(rowErrors) != 0 || len(materials) != 1 {
+		t.Fatalf("want: 1 material without row errors, got: %v, %v", materials, rowErrors)
+	}
+
+	m := materials[0]
+	if m.Plant != "1000" || m.Type != "ERSA" || m.UoM != "PC" || m.Group != "G001" || m.Manufacturer == nil || *m.Manufacturer != "SKF" || m.PartNumber == nil || *m.PartNumber != "6205-2RS" || m.LongText != "BEARING, BALL; 6205-2RS" {
+		t.Errorf("want: template row, got: %+v", m)
+	}
+}
@@ -0,0 +1,312 @@
+package model
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+type Abbreviation struct {
+	Term         string `json:"term"`
+	Abbreviation string `json:"abbreviation"`
+	CreatedAt    int64  `json:"createdAt"`
+	UpdatedAt    int64  `json:"updatedAt"`
+}
+
+type Abbreviations struct {
+	Data  []*Abbreviation `json:"data"`
+	Count int64           `json:"count"`
+}
+
+func (a *Abbreviations) Scan(src any) error {
+	if src == nil {
+		return nil
+	}
+
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to convert src of type [%T] to []byte", src)
+	}
+
+	return json.Unmarshal(b, a)
+}
+
+func (a *Abbreviations) Response(page Page) map[string]any {
+	if a == nil {
+		return nil
+	}
+
+	return map[string]any{
+		"data": a.Data,
+		"meta": meta(a.Count, page.ItemPerPage, page.Number),
+	}
+}
+
+type UpsertAbbreviationRequest struct {
+	Term         string `json:"term"`
+	Abbreviation string `json:"abbreviation"`
+}
+
+func (r *UpsertAbbreviationRequest) Validate() error {
+	if r == nil {
+		return errors.New("missing request object")
+	}
+
+	messages := make([]string, 0, 5)
+
+	if len(strings.TrimSpace(r.Term)) == 0 {
+		messages = append(messages, "abbreviation's term is required")
+	}
+
+	if len(r.Term) > 250 {
+		messages = append(messages, "abbreviation's term is too long")
+	}
+
+	if len(strings.TrimSpace(r.Abbreviation)) == 0 {
+		messages = append(messages, "abbreviation is required")
+	}
+
+	if utf8.RuneCountInString(r.Abbreviation) > MaxShortTextLength {
+		messages = append(messages, "abbreviation is too long")
+	}
+
+	if len(r.Abbreviation) > 0 && !shortTextPattern.MatchString(strings.ToUpper(r.Abbreviation)) {
+		messages = append(messages, "abbreviation contains unsupported characters")
+	}
+
+	if len(messages) > 0 {
+		return errors.New(strings.Join(messages, ","))
+	}
+
+	return nil
+}
+
+func (r UpsertAbbreviationRequest) Model() Abbreviation {
+	return Abbreviation{
+		Term:         normalizeShortTextSegment(r.Term),
+		Abbreviation: normalizeShortTextSegment(r.Abbreviation),
+	}
+}
+
+func NormalizeAbbreviationTerm(term string) string {
+	return normalizeShortTextSegment(term)
+}
+
+type ListAbbreviationsCriteria struct {
+	FilterAbbreviation
+	Sort
+	Page
+}
+
+type FilterAbbreviation struct {
+	Term string
+}
+
+type PreviewShortTextRequest struct {
+	LongText  string  `json:"longText"`
+	ShortText *string `json:"shortText"`
+}
+
+func (r *PreviewShortTextRequest) Validate() error {
+	if r == nil {
+		return errors.New("missing request object")
+	}
+
+	messages := make([]string, 0, 5)
+
+	if len(strings.TrimSpace(r.LongText)) == 0 {
+		messages = append(messages, "material long text is required")
+	}
+
+	if len(r.LongText) > 1023 {
+		messages = append(messages, "material long text is too long")
+	}
+
+	if len(messages) > 0 {
+		return errors.New(strings.Join(messages, ","))
+	}
+
+	return nil
+}
+
+type ShortTextPreview struct {
+	Suggestions []string `json:"suggestions"`
+	Violations  []string `json:"violations"`
+}
+
+const MaxShortTextLength = 40
+
+var (
+	shortTextPattern       = regexp.MustCompile(`^[A-Z0-9 ,.\-/"#&+()%:]+$`)
+	shortTextUnsupported   = regexp.MustCompile(`[^A-Z0-9 .\-/"#&+()%:]+`)
+	shortTextSegmentSplit  = regexp.MustCompile(`[,;\n]+`)
+	shortTextRepeatedSpace = regexp.MustCompile(`\s+`)
+)
+
+func ShortTextViolations(shortText string) []string {
+	violations := make([]string, 0, 5)
+
+	if len(strings.TrimSpace(shortText)) == 0 {
+		return append(violations, "short text is required")
+	}
+
+	if n := utf8.RuneCountInString(shortText); n > MaxShortTextLength {
+		violations = append(violations, fmt.Sprintf("short text exceeds %d characters: %d", MaxShortTextLength, n))
+	}
+
+	if shortText != strings.ToUpper(shortText) {
+		violations = append(violations, "short text must be in uppercase")
+	}
+
+	if !shortTextPattern.MatchString(strings.ToUpper(shortText)) {
+		violations = append(violations, "short text contains unsupported characters")
+	}
+
+	noun, modifier, found := strings.Cut(shortText, ",")
+	if !found || len(strings.TrimSpace(noun)) == 0 || len(strings.TrimSpace(modifier)) == 0 {
+		violations = append(violations, "short text must start with NOUN,MODIFIER")
+	}
+
+	return violations
+}
+
+func ValidateShortText(shortText string) error {
+	if violations := ShortTextViolations(shortText); len(violations) > 0 {
+		return errors.New(strings.Join(violations, ", "))
+	}
+
+	return nil
+}
+
+type abbreviationRule struct {
+	pattern      *regexp.Regexp
+	abbreviation string
+}
+
+type ShortTextGenerator struct {
+	rules []abbreviationRule
+}
+
+func NewShortTextGenerator(abbreviations []*Abbreviation) *ShortTextGenerator {
+	sorted := slices.Clone(abbreviations)
+	slices.SortStableFunc(sorted, func(a, b *Abbreviation) int {
+		return cmp.Compare(len(b.Term), len(a.Term))
+	})
+
+	g := &ShortTextGenerator{rules: make([]abbreviationRule, 0, len(sorted))}
+	for i := range sorted {
+		term := normalizeShortTextSegment(sorted[i].Term)
+		if len(term) == 0 {
+			continue
+		}
+
+		pattern := regexp.QuoteMeta(term)
+		if isWordRune(term[0]) {
+			pattern = `\b` + pattern
+		}
+		if isWordRune(term[len(term)-1]) {
+			pattern = pattern + `\b`
+		}
+
+		g.rules = append(g.rules, abbreviationRule{
+			pattern:      regexp.MustCompile(pattern),
+			abbreviation: normalizeShortTextSegment(sorted[i].Abbreviation),
+		})
+	}
+
+	return g
+}
+
+func (g *ShortTextGenerator) Suggest(longText string) []string {
+	segments := splitShortTextSegments(longText)
+	if len(segments) == 0 {
+		return []string{}
+	}
+
+	abbreviated := make([]string, len(segments))
+	for i := range segments {
+		abbreviated[i] = g.abbreviate(segments[i])
+	}
+
+	candidates := []string{
+		composeShortText(abbreviated, ","),
+		composeShortText(segments, ","),
+		composeShortText(abbreviated[:2], ","),
+		composeShortText(abbreviated, ", "),
+	}
+
+	suggestions := make([]string, 0, len(candidates))
+	for i := range candidates {
+		if ValidateShortText(candidates[i]) == nil && !slices.Contains(suggestions, candidates[i]) {
+			suggestions = append(suggestions, candidates[i])
+		}
+	}
+
+	return suggestions
+}
+
+func (g *ShortTextGenerator) abbreviate(segment string) string {
+	for i := range g.rules {
+		segment = g.rules[i].pattern.ReplaceAllLiteralString(segment, g.rules[i].abbreviation)
+	}
+
+	return segment
+}
+
+func isWordRune(c byte) bool {
+	return ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+func normalizeShortTextSegment(s string) string {
+	s = shortTextUnsupported.ReplaceAllString(strings.ToUpper(s), " ")
+	return strings.TrimSpace(shortTextRepeatedSpace.ReplaceAllString(s, " "))
+}
+
+func splitShortTextSegments(longText string) []string {
+	segments := make([]string, 0, 5)
+	for _, s := range shortTextSegmentSplit.Split(longText, -1) {
+		if s = normalizeShortTextSegment(s); len(s) > 0 {
+			segments = append(segments, s)
+		}
+	}
+
+	if len(segments) == 1 {
+		if noun, modifier, found := strings.Cut(segments[0], " "); found {
+			segments = []string{noun, modifier}
+		}
+	}
+
+	if len(segments) == 1 {
+		return nil
+	}
+
+	return segments
+}
+
+func composeShortText(segments []string, separator string) string {
+	var b strings.Builder
+	b.WriteString(segments[0])
+
+	for i := 1; i < len(segments); i++ {
+		if utf8.RuneCountInString(b.String())+len(separator)+utf8.RuneCountInString(segments[i]) > MaxShortTextLength {
+			if i == 1 {
+				b.WriteString(separator + segments[i])
+				break
+			}
+			continue
+		}
+		b.WriteString(separator + segments[i])
+	}
+
+	s := []rune(b.String())
+	if len(s) > MaxShortTextLength {
+		s = s[:MaxShortTextLength]
+	}
+
+	return strings.TrimSpace(string(s))
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestShortTextGeneratorSuggest(t *testing.T) {
+	g := NewShortTextGenerator([]*Abbreviation{
+		{Term: "BEARING", Abbreviation: "BRG"},
+		{Term: "stainless steel", Abbreviation: "SS"},
+		{Term: "STAINLESS", Abbreviation: "STNL"},
+		{Term: "VALVE", Abbreviation: "VLV"},
+	})
+
+	tests := []struct {
+		name     string
+		longText string
+		want     []string
+	}{
+		{
+			name:     "abbreviated noun and modifier",
+			longText: "Bearing, Ball; 6205-2RS",
+			want:     []string{"BRG,BALL,6205-2RS", "BEARING,BALL,6205-2RS", "BRG,BALL", "BRG, BALL, 6205-2RS"},
+		},
+		{
+			name:     "longest term is applied first",
+			longText: "VALVE, GATE; STAINLESS STEEL BODY, 2IN, CLASS 150, FLANGED RF, HANDWHEEL OPERATED",
+			want:     []string{"VLV,GATE,SS BODY,2IN,CLASS 150", "VALVE,GATE,STAINLESS STEEL BODY,2IN", "VLV,GATE", "VLV, GATE, SS BODY, 2IN, CLASS 150"},
+		},
+		{
+			name:     "single segment is split into noun and modifier",
+			longText: "gasket spiral wound",
+			want:     []string{"GASKET,SPIRAL WOUND", "GASKET, SPIRAL WOUND"},
+		},
+		{
+			name:     "single word",
+			longText: "gasket",
+			want:     []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := g.Suggest(tt.longText); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Suggest() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateShortText(t *testing.T) {
+	tests := []struct {
+		name      string
+		shortText string
+		wantErr   bool
+	}{
+		{name: "valid", shortText: "BRG,BALL,6205-2RS", wantErr: false},
+		{name: "empty", shortText: " ", wantErr: true},
+		{name: "lowercase", shortText: "brg,ball", wantErr: true},
+		{name: "missing modifier", shortText: "BRG 6205", wantErr: true},
+		{name: "too long", shortText: "BRG,BALL,6205-2RS,25X52X15MM,DOUBLE SEALED,C3", wantErr: true},
+		{name: "unsupported characters", shortText: "BRG,BALL;6205", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateShortText(tt.shortText); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateShortText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -21,6 +21,10 @@ func (a Auth) IsAdmin() bool {
 	return a.Role == Administrator
 }
 
+func (a Auth) IsReviewer() bool {
+	return a.Role == Cataloger || a.Role == Approver || a.Role == Administrator
+}
+
 func (a Auth) MapClaims(isRefreshToken bool) map[string]any {
 	m := map[string]any{
 		"userID":    a.UserID,
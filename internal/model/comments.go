@@ -0,0 +1,145 @@
+package model
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type Comment struct {
+	ID         UUID       `json:"id"`
+	RequestID  UUID       `json:"requestID"`
+	MaterialID *UUID      `json:"materialID"`
+	ParentID   *UUID      `json:"parentID"`
+	Content    string     `json:"content"`
+	Mentions   []string   `json:"mentions"`
+	CreatedBy  User       `json:"createdBy"`
+	CreatedAt  int64      `json:"createdAt"`
+	UpdatedAt  int64      `json:"updatedAt"`
+	Replies    []*Comment `json:"replies,omitempty"`
+}
+
+func (c *Comment) Scan(src any) error {
+	if src == nil {
+		return nil
+	}
+
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to convert src of type [%T] to []byte", src)
+	}
+
+	return json.Unmarshal(b, c)
+}
+
+func (c Comment) NewNotificationEmail(recipient User, appBaseURL string) *Email {
+	return NewHTMLEmail(
+		"[Cataloging] Komentar Baru pada Permintaan",
+		fmt.Sprintf(emailComment, recipient.Name, c.CreatedBy.Name, c.RequestID, html.EscapeString(c.Content), fmt.Sprintf("%s/requests/%s", strings.TrimSuffix(appBaseURL, "/"), c.RequestID)),
+		recipient.Email,
+	)
+}
+
+type Comments []*Comment
+
+func (c *Comments) Scan(src any) error {
+	if src == nil {
+		return nil
+	}
+
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to convert src of type [%T] to []byte", src)
+	}
+
+	return json.Unmarshal(b, c)
+}
+
+func (c Comments) Thread() []*Comment {
+	slices.SortStableFunc(c, func(a, b *Comment) int {
+		return cmp.Compare(a.CreatedAt, b.CreatedAt)
+	})
+
+	roots := make([]*Comment, 0, len(c))
+	byID := make(map[UUID]*Comment, len(c))
+	for i := range c {
+		if c[i].ParentID == nil {
+			roots = append(roots, c[i])
+			byID[c[i].ID] = c[i]
+		}
+	}
+
+	for i := range c {
+		if c[i].ParentID == nil {
+			continue
+		}
+		if parent, exists := byID[*c[i].ParentID]; exists {
+			parent.Replies = append(parent.Replies, c[i])
+		}
+	}
+
+	return roots
+}
+
+var mentionPattern = regexp.MustCompile(`(?:^|\s)@([A-Za-z0-9._-]+)`)
+
+func ParseMentions(content string) []string {
+	mentions := make([]string, 0, 5)
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		if !slices.Contains(mentions, match[1]) {
+			mentions = append(mentions, match[1])
+		}
+	}
+
+	return mentions
+}
+
+type UpsertCommentRequest struct {
+	MaterialID *UUID  `json:"materialID"`
+	ParentID   *UUID  `json:"parentID"`
+	Content    string `json:"content"`
+}
+
+func (r *UpsertCommentRequest) Validate() error {
+	if r == nil {
+		return errors.New("missing request object")
+	}
+
+	messages := make([]string, 0, 5)
+
+	if len(strings.TrimSpace(r.Content)) == 0 {
+		messages = append(messages, "comment content is required")
+	}
+
+	if len(r.Content) > 4095 {
+		messages = append(messages, "maximum length of comment content is 4095 characters")
+	}
+
+	if len(messages) > 0 {
+		return errors.New(strings.Join(messages, ", "))
+	}
+
+	return nil
+}
+
+func (r UpsertCommentRequest) Model(ID *UUID, requestID UUID, createdBy *Auth) Comment {
+	return Comment{
+		ID: func(id *UUID) UUID {
+			if id == nil {
+				return NewUUID()
+			}
+			return *id
+		}(ID),
+		RequestID:  requestID,
+		MaterialID: r.MaterialID,
+		ParentID:   r.ParentID,
+		Content:    r.Content,
+		Mentions:   ParseMentions(r.Content),
+		CreatedBy:  User{ID: createdBy.UserID, Email: createdBy.UserEmail},
+	}
+}
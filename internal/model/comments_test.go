@@ -0,0 +1,55 @@
+package model
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "no mention",
+			content: "please attach the datasheet",
+			want:    []string{},
+		},
+		{
+			name:    "single mention",
+			content: "@dummy-id please attach the datasheet",
+			want:    []string{"dummy-id"},
+		},
+		{
+			name:    "duplicate mentions",
+			content: "@dummy.one and @dummy_two, see above @dummy.one",
+			want:    []string{"dummy.one", "dummy_two"},
+		},
+		{
+			name:    "email address is not a mention",
+			content: "send it to dummy@bai.id",
+			want:    []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseMentions(tt.content); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseMentions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommentNewNotificationEmailLinksRequest(t *testing.T) {
+	c := Comment{RequestID: NewUUID(), Content: "please attach the datasheet", CreatedBy: User{Name: "Dummy"}}
+
+	email := c.NewNotificationEmail(User{Name: "Reviewer", Email: "reviewer@bai.id"}, "https://cataloging.bai.id/")
+
+	want := `href="https://cataloging.bai.id/requests/` + c.RequestID.String() + `"`
+	if !strings.Contains(email.Message.Body.Content, want) {
+		t.Errorf("want: %v, got: %v", want, email.Message.Body.Content)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"strings"
 )
 
 type UUID [16]byte
@@ -203,6 +204,51 @@ func IsAvailableToSortManufacturer(fieldName string) bool {
 	return availableToSort
 }
 
+var materialsFieldToSort map[string]struct{} = map[string]struct{}{
+	"number":     {},
+	"plant_code": {},
+	"type_code":  {},
+	"group_code": {},
+	"short_text": {},
+	"long_text":  {},
+	"status":     {},
+	"created_at": {},
+	"updated_at": {},
+}
+
+func IsAvailableToSortMaterial(fieldName string) bool {
+	_, availableToSort := materialsFieldToSort[fieldName]
+
+	return availableToSort
+}
+
+var abbreviationsFieldToSort map[string]struct{} = map[string]struct{}{
+	"term":         {},
+	"abbreviation": {},
+}
+
+func IsAvailableToSortAbbreviation(fieldName string) bool {
+	_, availableToSort := abbreviationsFieldToSort[fieldName]
+
+	return availableToSort
+}
+
+var requestsFieldToSort map[string]struct{} = map[string]struct{}{
+	"id":           {},
+	"subject":      {},
+	"is_new":       {},
+	"requested_by": {},
+	"status":       {},
+	"created_at":   {},
+	"updated_at":   {},
+}
+
+func IsAvailableToSortRequest(fieldName string) bool {
+	_, availableToSort := requestsFieldToSort[fieldName]
+
+	return availableToSort
+}
+
 type Flag bool
 
 func NewFlag(b bool) *Flag {
@@ -250,6 +296,22 @@ type List struct {
 	Meta Meta `json:"meta"`
 }
 
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+type FieldErrors []FieldError
+
+func (fe FieldErrors) Error() string {
+	messages := make([]string, len(fe))
+	for i := range fe {
+		messages[i] = fe[i].Message
+	}
+
+	return strings.Join(messages, ",")
+}
+
 type Meta struct {
 	TotalRecords int64  `json:"totalRecords"`
 	TotalPages   int64  `json:"totalPages"`
@@ -0,0 +1,146 @@
+package model
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/dev-pt-bai/cataloging/internal/pkg/similarity"
+)
+
+const (
+	duplicateThreshold     = 0.5
+	maxDuplicateCandidates = 5
+)
+
+type DuplicateCandidate struct {
+	MaterialID   UUID    `json:"materialID"`
+	Number       *string `json:"number"`
+	RequestID    UUID    `json:"requestID"`
+	Status       Status  `json:"status"`
+	LongText     string  `json:"longText"`
+	Manufacturer *string `json:"manufacturer"`
+	PartNumber   *string `json:"partNumber"`
+	Score        float64 `json:"score"`
+}
+
+type DuplicateFilter struct {
+	PlantCode       string
+	TypeCode        string
+	ShortTextPrefix string
+}
+
+func (f DuplicateFilter) Matches(m Material) bool {
+	if m.Plant.Code != f.PlantCode || m.Type.Code != f.TypeCode {
+		return false
+	}
+
+	if len(f.ShortTextPrefix) == 0 {
+		return true
+	}
+
+	return m.ShortText != nil && strings.HasPrefix(similarity.Normalize(*m.ShortText), f.ShortTextPrefix)
+}
+
+type MaterialDuplicates struct {
+	MaterialID UUID                 `json:"materialID"`
+	Candidates []DuplicateCandidate `json:"candidates"`
+}
+
+func (m Material) DuplicateScore(other Material) float64 {
+	score := 0.6 * similarity.Text(m.LongText, other.LongText)
+	weight := 0.6
+
+	if m.Manufacturer != nil && other.Manufacturer != nil {
+		score += 0.15 * similarity.Code(m.Manufacturer.Code, other.Manufacturer.Code)
+		weight += 0.15
+	}
+
+	if m.PartNumber != nil && other.PartNumber != nil {
+		score += 0.25 * similarity.Code(*m.PartNumber, *other.PartNumber)
+		weight += 0.25
+	}
+
+	return math.Round(score/weight*1000) / 1000
+}
+
+func (m Material) DuplicateFilter() DuplicateFilter {
+	f := DuplicateFilter{PlantCode: m.Plant.Code, TypeCode: m.Type.Code}
+	if m.ShortText != nil {
+		f.ShortTextPrefix, _, _ = strings.Cut(similarity.Normalize(*m.ShortText), " ")
+	}
+
+	return f
+}
+
+func FindDuplicates(materials []Material, candidates []Material) []MaterialDuplicates {
+	duplicates := make([]MaterialDuplicates, 0, len(materials))
+	for i := range materials {
+		filter := materials[i].DuplicateFilter()
+		matches := make([]DuplicateCandidate, 0, maxDuplicateCandidates)
+		for j := range candidates {
+			if candidates[j].ID == materials[i].ID || !filter.Matches(candidates[j]) {
+				continue
+			}
+
+			score := materials[i].DuplicateScore(candidates[j])
+			if score < duplicateThreshold {
+				continue
+			}
+
+			matches = append(matches, DuplicateCandidate{
+				MaterialID:   candidates[j].ID,
+				Number:       candidates[j].Number,
+				RequestID:    candidates[j].RequestID,
+				Status:       candidates[j].Status,
+				LongText:     candidates[j].LongText,
+				Manufacturer: candidates[j].Manufacturer.SafeCode(),
+				PartNumber:   candidates[j].PartNumber,
+				Score:        score,
+			})
+		}
+
+		if len(matches) == 0 {
+			continue
+		}
+
+		slices.SortStableFunc(matches, func(a, b DuplicateCandidate) int {
+			return cmp.Compare(b.Score, a.Score)
+		})
+
+		duplicates = append(duplicates, MaterialDuplicates{
+			MaterialID: materials[i].ID,
+			Candidates: matches[:min(len(matches), maxDuplicateCandidates)],
+		})
+	}
+
+	return duplicates
+}
+
+type MarkDuplicateRequest struct {
+	MaterialNumber string `json:"materialNumber"`
+}
+
+func (r *MarkDuplicateRequest) Validate() error {
+	if r == nil {
+		return errors.New("missing request object")
+	}
+
+	messages := make([]string, 0, 5)
+
+	if len(r.MaterialNumber) == 0 {
+		messages = append(messages, "material number is required")
+	}
+
+	if len(r.MaterialNumber) > 255 {
+		messages = append(messages, "maximum length of material number is 255 characters")
+	}
+
+	if len(messages) > 0 {
+		return errors.New(strings.Join(messages, ", "))
+	}
+
+	return nil
+}
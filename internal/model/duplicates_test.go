@@ -0,0 +1,116 @@
+package model
+
+import "testing"
+
+func TestFindDuplicates(t *testing.T) {
+	partNumber := func(s string) *string { return &s }
+
+	material := Material{
+		ID:           NewUUID(),
+		LongText:     "BEARING, BALL; 6205-2RS, 25X52X15MM",
+		Manufacturer: &Manufacturer{Code: "SKF"},
+		PartNumber:   partNumber("6205-2RS"),
+	}
+
+	exact := Material{
+		ID:           NewUUID(),
+		LongText:     "bearing ball 6205 2rs 25x52x15mm",
+		Manufacturer: &Manufacturer{Code: "SKF"},
+		PartNumber:   partNumber("62052RS"),
+		Status:       Published,
+	}
+
+	similar := Material{
+		ID:       NewUUID(),
+		LongText: "BEARING BALL 6205-2RS",
+		Status:   Submitted,
+	}
+
+	unrelated := Material{
+		ID:           NewUUID(),
+		LongText:     "GASKET, SPIRAL WOUND; 4IN, 150LB",
+		Manufacturer: &Manufacturer{Code: "FLEXITALLIC"},
+		PartNumber:   partNumber("CG-4-150"),
+		Status:       Published,
+	}
+
+	got := FindDuplicates([]Material{material}, []Material{unrelated, similar, exact, material})
+	if len(got) != 1 {
+		t.Fatalf("FindDuplicates() returns %d materials, want 1", len(got))
+	}
+
+	if got[0].MaterialID != material.ID {
+		t.Errorf("FindDuplicates() material = %v, want %v", got[0].MaterialID, material.ID)
+	}
+
+	if len(got[0].Candidates) != 2 {
+		t.Fatalf("FindDuplicates() returns %d candidates, want 2", len(got[0].Candidates))
+	}
+
+	if got[0].Candidates[0].MaterialID != exact.ID || got[0].Candidates[0].Score != 1 {
+		t.Errorf("FindDuplicates() top candidate = %v (%v), want %v (1)", got[0].Candidates[0].MaterialID, got[0].Candidates[0].Score, exact.ID)
+	}
+
+	if got[0].Candidates[1].MaterialID != similar.ID {
+		t.Errorf("FindDuplicates() second candidate = %v, want %v", got[0].Candidates[1].MaterialID, similar.ID)
+	}
+
+	if got := FindDuplicates([]Material{material}, []Material{unrelated}); len(got) != 0 {
+		t.Errorf("FindDuplicates() = %v, want empty", got)
+	}
+}
+
+func TestDuplicateFilterMatches(t *testing.T) {
+	shortText := func(s string) *string { return &s }
+
+	material := Material{
+		Plant:     Plant{Code: "1000"},
+		Type:      MaterialType{Code: "ERSA"},
+		ShortText: shortText("BEARING,BALL:6205-2RS"),
+	}
+
+	tests := []struct {
+		name      string
+		candidate Material
+		want      bool
+	}{
+		{
+			name:      "same plant, type and noun",
+			candidate: Material{Plant: Plant{Code: "1000"}, Type: MaterialType{Code: "ERSA"}, ShortText: shortText("bearing roller 6205")},
+			want:      true,
+		},
+		{
+			name:      "other plant",
+			candidate: Material{Plant: Plant{Code: "2000"}, Type: MaterialType{Code: "ERSA"}, ShortText: shortText("BEARING,BALL:6205-2RS")},
+			want:      false,
+		},
+		{
+			name:      "other type",
+			candidate: Material{Plant: Plant{Code: "1000"}, Type: MaterialType{Code: "HAWA"}, ShortText: shortText("BEARING,BALL:6205-2RS")},
+			want:      false,
+		},
+		{
+			name:      "other noun",
+			candidate: Material{Plant: Plant{Code: "1000"}, Type: MaterialType{Code: "ERSA"}, ShortText: shortText("GASKET,SPIRAL WOUND")},
+			want:      false,
+		},
+		{
+			name:      "missing short text",
+			candidate: Material{Plant: Plant{Code: "1000"}, Type: MaterialType{Code: "ERSA"}},
+			want:      false,
+		},
+	}
+
+	filter := material.DuplicateFilter()
+	if filter.ShortTextPrefix != "bearing" {
+		t.Errorf("DuplicateFilter() prefix = %v, want bearing", filter.ShortTextPrefix)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := filter.Matches(tt.candidate); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
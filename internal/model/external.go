@@ -154,3 +154,103 @@ func (e *Email) NewTask(taskType string) *manager.Task {
 		RetryCount: 0,
 	}
 }
+
+type SAPProduct struct {
+	Product                   string                 `json:"Product,omitempty"`
+	ProductType               string                 `json:"ProductType"`
+	BaseUnit                  string                 `json:"BaseUnit"`
+	ProductGroup              string                 `json:"ProductGroup"`
+	ManufacturerNumber        string                 `json:"ManufacturerNumber,omitempty"`
+	ProductManufacturerNumber string                 `json:"ProductManufacturerNumber,omitempty"`
+	Description               SAPProductDescriptions `json:"to_Description"`
+	Plant                     SAPProductPlants       `json:"to_Plant"`
+	Valuation                 SAPProductValuations   `json:"to_Valuation"`
+	BasicText                 SAPProductBasicTexts   `json:"to_ProductBasicText"`
+}
+
+type SAPProductDescriptions struct {
+	Results []SAPProductDescription `json:"results"`
+}
+
+type SAPProductDescription struct {
+	Product            string `json:"Product,omitempty"`
+	Language           string `json:"Language"`
+	ProductDescription string `json:"ProductDescription"`
+}
+
+type SAPProductPlants struct {
+	Results []SAPProductPlant `json:"results"`
+}
+
+type SAPProductPlant struct {
+	Plant string `json:"Plant"`
+}
+
+type SAPProductValuations struct {
+	Results []SAPProductValuation `json:"results"`
+}
+
+type SAPProductValuation struct {
+	ValuationArea  string `json:"ValuationArea"`
+	ValuationClass string `json:"ValuationClass,omitempty"`
+}
+
+type SAPProductBasicTexts struct {
+	Results []SAPProductBasicText `json:"results"`
+}
+
+type SAPProductBasicText struct {
+	Language string `json:"Language"`
+	LongText string `json:"LongText"`
+}
+
+func NewSAPProduct(m Material) SAPProduct {
+	p := SAPProduct{
+		ProductType:  m.Type.Code,
+		BaseUnit:     m.UoM.Code,
+		ProductGroup: m.Group.Code,
+		Plant:        SAPProductPlants{Results: []SAPProductPlant{{Plant: m.Plant.Code}}},
+		Valuation:    SAPProductValuations{Results: []SAPProductValuation{{ValuationArea: m.Plant.Code}}},
+		BasicText:    SAPProductBasicTexts{Results: []SAPProductBasicText{{Language: "EN", LongText: m.LongText}}},
+	}
+
+	if m.Manufacturer != nil {
+		p.ManufacturerNumber = m.Manufacturer.Code
+	}
+
+	if m.PartNumber != nil {
+		p.ProductManufacturerNumber = *m.PartNumber
+	}
+
+	if m.Type.ValuationClass != nil {
+		p.Valuation.Results[0].ValuationClass = *m.Type.ValuationClass
+	}
+
+	if m.ShortText != nil {
+		p.Description.Results = append(p.Description.Results, SAPProductDescription{Language: "EN", ProductDescription: *m.ShortText})
+	}
+
+	return p
+}
+
+type SAPProductResponse struct {
+	Data *SAPProduct `json:"d"`
+}
+
+type SAPErrorResponse struct {
+	Error *SAPError `json:"error"`
+}
+
+type SAPError struct {
+	Code    string `json:"code"`
+	Message struct {
+		Value string `json:"value"`
+	} `json:"message"`
+}
+
+func (e *SAPError) Error() string {
+	if e == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("code: %v, message: %v", e.Code, e.Message.Value)
+}
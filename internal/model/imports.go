@@ -0,0 +1,225 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dev-pt-bai/cataloging/internal/pkg/async/manager"
+)
+
+type BulkImportMode string
+
+const (
+	BulkInsert       BulkImportMode = "insert"
+	BulkUpsert       BulkImportMode = "upsert"
+	BulkSkipExisting BulkImportMode = "skip"
+)
+
+func ParseBulkImportMode(s string) (BulkImportMode, error) {
+	switch mode := BulkImportMode(strings.ToLower(strings.TrimSpace(s))); mode {
+	case "":
+		return BulkInsert, nil
+	case BulkInsert, BulkUpsert, BulkSkipExisting:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown bulk import mode: %s", s)
+	}
+}
+
+type BulkImportStatus string
+
+const (
+	BulkImportCreated    BulkImportStatus = "created"
+	BulkImportUpdated    BulkImportStatus = "updated"
+	BulkImportUnchanged  BulkImportStatus = "unchanged"
+	BulkImportSkipped    BulkImportStatus = "skipped"
+	BulkImportFailed     BulkImportStatus = "failed"
+	BulkImportRolledBack BulkImportStatus = "rolledBack"
+)
+
+type BulkImportError struct {
+	Column    string `json:"column,omitempty"`
+	ErrorCode string `json:"errorCode"`
+	Message   string `json:"message"`
+}
+
+type BulkImportRow struct {
+	Row    int               `json:"row"`
+	Code   string            `json:"code"`
+	Status BulkImportStatus  `json:"status"`
+	Errors []BulkImportError `json:"errors,omitempty"`
+}
+
+type BulkImportReport struct {
+	Mode       BulkImportMode  `json:"mode"`
+	Created    int             `json:"created"`
+	Updated    int             `json:"updated"`
+	Unchanged  int             `json:"unchanged"`
+	Skipped    int             `json:"skipped"`
+	Failed     int             `json:"failed"`
+	RolledBack int             `json:"rolledBack"`
+	Rows       []BulkImportRow `json:"rows"`
+}
+
+func (r *BulkImportReport) Scan(src any) error {
+	if src == nil {
+		return nil
+	}
+
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to convert src of type [%T] to []byte", src)
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+func NewBulkImportReport(mode BulkImportMode) *BulkImportReport {
+	return &BulkImportReport{Mode: mode, Rows: make([]BulkImportRow, 0)}
+}
+
+func (r *BulkImportReport) Add(row BulkImportRow) {
+	switch row.Status {
+	case BulkImportCreated:
+		r.Created++
+	case BulkImportUpdated:
+		r.Updated++
+	case BulkImportUnchanged:
+		r.Unchanged++
+	case BulkImportSkipped:
+		r.Skipped++
+	case BulkImportFailed:
+		r.Failed++
+	case BulkImportRolledBack:
+		r.RolledBack++
+	}
+
+	r.Rows = append(r.Rows, row)
+}
+
+var BulkImportReportColumns = []string{"Row", "Code", "Status", "Column", "Error Code", "Message"}
+
+func (r *BulkImportReport) SpreadsheetRows() ([][]string, []int) {
+	rows := make([][]string, 0, len(r.Rows))
+	failed := make([]int, 0, r.Failed)
+	for i := range r.Rows {
+		row := r.Rows[i]
+		if len(row.Errors) == 0 {
+			rows = append(rows, []string{strconv.Itoa(row.Row), row.Code, string(row.Status), "", "", ""})
+			continue
+		}
+		for j := range row.Errors {
+			if row.Status == BulkImportFailed {
+				failed = append(failed, len(rows))
+			}
+			rows = append(rows, []string{strconv.Itoa(row.Row), row.Code, string(row.Status), row.Errors[j].Column, row.Errors[j].ErrorCode, row.Errors[j].Message})
+		}
+	}
+
+	return rows, failed
+}
+
+type ImportEntity string
+
+const (
+	ImportMaterialTypes  ImportEntity = "material_types"
+	ImportMaterialUoMs   ImportEntity = "material_uoms"
+	ImportMaterialGroups ImportEntity = "material_groups"
+	ImportPlants         ImportEntity = "plants"
+	ImportManufacturers  ImportEntity = "manufacturers"
+)
+
+func (e ImportEntity) IsValid() bool {
+	switch e {
+	case ImportMaterialTypes, ImportMaterialUoMs, ImportMaterialGroups, ImportPlants, ImportManufacturers:
+		return true
+	default:
+		return false
+	}
+}
+
+type ImportJobStatus string
+
+const (
+	ImportJobQueued     ImportJobStatus = "queued"
+	ImportJobProcessing ImportJobStatus = "processing"
+	ImportJobPreviewed  ImportJobStatus = "previewed"
+	ImportJobCompleted  ImportJobStatus = "completed"
+	ImportJobFailed     ImportJobStatus = "failed"
+	ImportJobExpired    ImportJobStatus = "expired"
+)
+
+type ImportJob struct {
+	ID            UUID              `json:"id"`
+	Entity        ImportEntity      `json:"entity"`
+	Mode          BulkImportMode    `json:"mode"`
+	DryRun        bool              `json:"dryRun"`
+	Status        ImportJobStatus   `json:"status"`
+	Filename      string            `json:"filename"`
+	ProcessedRows int               `json:"processedRows"`
+	Report        *BulkImportReport `json:"report"`
+	ErrorCode     *string           `json:"errorCode"`
+	CreatedBy     string            `json:"createdBy"`
+	CreatedAt     int64             `json:"createdAt"`
+	UpdatedAt     int64             `json:"updatedAt"`
+}
+
+type CreateImportJobRequest struct {
+	Entity ImportEntity
+	Mode   BulkImportMode
+	DryRun bool
+}
+
+func (r *CreateImportJobRequest) Validate() error {
+	if r == nil {
+		return errors.New("missing request object")
+	}
+
+	messages := make([]string, 0, 2)
+
+	if !r.Entity.IsValid() {
+		messages = append(messages, "import entity is invalid")
+	}
+
+	if _, err := ParseBulkImportMode(string(r.Mode)); err != nil {
+		messages = append(messages, "import mode is invalid")
+	}
+
+	if len(messages) > 0 {
+		return errors.New(strings.Join(messages, ","))
+	}
+
+	return nil
+}
+
+func (r CreateImportJobRequest) Model(filename string, requestedBy *Auth) ImportJob {
+	mode, _ := ParseBulkImportMode(string(r.Mode))
+
+	return ImportJob{
+		ID:        NewUUID(),
+		Entity:    r.Entity,
+		Mode:      mode,
+		DryRun:    r.DryRun,
+		Status:    ImportJobQueued,
+		Filename:  filename,
+		CreatedBy: requestedBy.UserID,
+	}
+}
+
+type ProcessImportTask struct {
+	JobID UUID `json:"jobID"`
+}
+
+func (t ProcessImportTask) NewTask(taskType string) *manager.Task {
+	data, _ := json.Marshal(t)
+
+	return &manager.Task{
+		ID:         NewUUID().String(),
+		Type:       taskType,
+		Data:       data,
+		RetryCount: 0,
+	}
+}
@@ -0,0 +1,81 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseBulkImportMode(t *testing.T) {
+	for s, want := range map[string]BulkImportMode{"": BulkInsert, "insert": BulkInsert, " Upsert ": BulkUpsert, "SKIP": BulkSkipExisting} {
+		if got, err := ParseBulkImportMode(s); err != nil || got != want {
+			t.Errorf("ParseBulkImportMode(%q) = %s, %v, want %s", s, got, err, want)
+		}
+	}
+
+	if _, err := ParseBulkImportMode("replace"); err == nil {
+		t.Errorf("ParseBulkImportMode(replace) returns nil error")
+	}
+}
+
+func TestBulkImportReport(t *testing.T) {
+	report := NewBulkImportReport(BulkUpsert)
+	report.Add(BulkImportRow{Row: 2, Code: "SHL", Status: BulkImportCreated})
+	report.Add(BulkImportRow{Row: 3, Code: "", Status: BulkImportFailed, Errors: []BulkImportError{
+		{Column: "Code", ErrorCode: "400012", Message: "manufacturer's code is required"},
+		{Column: "Description", ErrorCode: "400012", Message: "manufacturer's description is required"},
+	}})
+	report.Add(BulkImportRow{Row: 4, Code: "PTM", Status: BulkImportUpdated})
+	report.Add(BulkImportRow{Row: 5, Code: "PTM", Status: BulkImportSkipped})
+	report.Add(BulkImportRow{Row: 6, Code: "SKF", Status: BulkImportUnchanged})
+
+	if report.Created != 1 || report.Updated != 1 || report.Unchanged != 1 || report.Skipped != 1 || report.Failed != 1 {
+		t.Errorf("report counts = %d/%d/%d/%d/%d, want 1/1/1/1/1", report.Created, report.Updated, report.Unchanged, report.Skipped, report.Failed)
+	}
+
+	rows, failed := report.SpreadsheetRows()
+	want := [][]string{
+		{"2", "SHL", "created", "", "", ""},
+		{"3", "", "failed", "Code", "400012", "manufacturer's code is required"},
+		{"3", "", "failed", "Description", "400012", "manufacturer's description is required"},
+		{"4", "PTM", "updated", "", "", ""},
+		{"5", "PTM", "skipped", "", "", ""},
+		{"6", "SKF", "unchanged", "", "", ""},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("SpreadsheetRows() rows = %q, want %q", rows, want)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(failed, want) {
+		t.Errorf("SpreadsheetRows() failed = %v, want %v", failed, want)
+	}
+}
+
+func TestFieldErrors(t *testing.T) {
+	err := (&UpsertPlantRequest{Description: "Balikpapan"}).Validate()
+
+	fieldErrors, ok := err.(FieldErrors)
+	if !ok {
+		t.Fatalf("Validate() error type = %T, want FieldErrors", err)
+	}
+	if len(fieldErrors) != 1 || fieldErrors[0].Field != "code" {
+		t.Errorf("Validate() = %+v, want single code error", fieldErrors)
+	}
+	if got, want := err.Error(), "plant's code is required"; got != want {
+		t.Errorf("Error() = %s, want %s", got, want)
+	}
+}
+
+func TestCreateImportJobRequestValidate(t *testing.T) {
+	if err := (&CreateImportJobRequest{Entity: ImportPlants}).Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+
+	err := (&CreateImportJobRequest{Entity: "requests", Mode: "replace"}).Validate()
+	if got, want := err.Error(), "import entity is invalid,import mode is invalid"; got != want {
+		t.Errorf("Validate() error = %v, want %s", err, want)
+	}
+
+	job := CreateImportJobRequest{Entity: ImportPlants, DryRun: true}.Model("plants.csv", &Auth{UserID: "admin"})
+	if job.Mode != BulkInsert || job.Status != ImportJobQueued || !job.DryRun || job.CreatedBy != "admin" {
+		t.Errorf("Model() = %+v, want queued insert dry run by admin", job)
+	}
+}
@@ -8,22 +8,68 @@ import (
 )
 
 type Material struct {
-	ID            UUID          `json:"id"`
-	Number        *string       `json:"number"`
-	Plant         Plant         `json:"plant"`
-	Type          MaterialType  `json:"type"`
-	UoM           MaterialUoM   `json:"uom"`
-	Manufacturer  *Manufacturer `json:"manufacturer"`
-	Group         MaterialGroup `json:"group"`
-	EquipmentCode *string       `json:"equipmentCode"`
-	ShortText     *string       `json:"shortText"`
-	LongText      string        `json:"longText"`
-	Note          *string       `json:"note"`
-	Status        Status        `json:"status"`
-	RequestID     UUID          `json:"requestID"`
-	CreatedAt     int64         `json:"createdAt"`
-	UpdatedAt     int64         `json:"updatedAt"`
-	Attachments   []Asset       `json:"attachments"`
+	ID            UUID            `json:"id"`
+	Number        *string         `json:"number"`
+	Plant         Plant           `json:"plant"`
+	Type          MaterialType    `json:"type"`
+	UoM           MaterialUoM     `json:"uom"`
+	Manufacturer  *Manufacturer   `json:"manufacturer"`
+	PartNumber    *string         `json:"partNumber"`
+	Group         MaterialGroup   `json:"group"`
+	EquipmentCode *string         `json:"equipmentCode"`
+	ShortText     *string         `json:"shortText"`
+	LongText      string          `json:"longText"`
+	Note          *string         `json:"note"`
+	Status        Status          `json:"status"`
+	RequestID     UUID            `json:"requestID"`
+	DuplicateOf   *string         `json:"duplicateOf"`
+	RevisionOf    *UUID           `json:"revisionOf"`
+	CreatedAt     int64           `json:"createdAt"`
+	UpdatedAt     int64           `json:"updatedAt"`
+	Attachments   []Asset         `json:"attachments"`
+	Review        *MaterialReview `json:"review"`
+}
+
+func (m *Material) Scan(src any) error {
+	if src == nil {
+		return nil
+	}
+
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to convert src of type [%T] to []byte", src)
+	}
+
+	return json.Unmarshal(b, m)
+}
+
+type Materials struct {
+	Data  []*Material `json:"data"`
+	Count int64       `json:"count"`
+}
+
+func (m *Materials) Scan(src any) error {
+	if src == nil {
+		return nil
+	}
+
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to convert src of type [%T] to []byte", src)
+	}
+
+	return json.Unmarshal(b, m)
+}
+
+func (m *Materials) Response(page Page) map[string]any {
+	if m == nil {
+		return nil
+	}
+
+	return map[string]any{
+		"data": m.Data,
+		"meta": meta(m.Count, page.ItemPerPage, page.Number),
+	}
 }
 
 type Plant struct {
@@ -226,12 +272,49 @@ type Asset struct {
 	UpdatedAt   int64   `json:"updatedAt"`
 }
 
+var MaterialSpreadsheetColumns = []string{"Number", "Plant", "Type", "UoM", "Group", "Manufacturer", "Part Number", "Equipment Code", "Short Text", "Long Text", "Note"}
+
+var MasterDataSpreadsheetColumns = []string{"Code", "Description"}
+
+var MaterialTypeSpreadsheetColumns = []string{"Code", "Description", "Valuation Class"}
+
+var MasterDataSpreadsheetColumnOfField = map[string]string{
+	"code":           "Code",
+	"description":    "Description",
+	"valuationClass": "Valuation Class",
+}
+
+func (m Material) SpreadsheetRow() []string {
+	value := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+
+	return []string{
+		value(m.Number),
+		m.Plant.Code,
+		m.Type.Code,
+		m.UoM.Code,
+		m.Group.Code,
+		value(m.Manufacturer.SafeCode()),
+		value(m.PartNumber),
+		value(m.EquipmentCode),
+		value(m.ShortText),
+		m.LongText,
+		value(m.Note),
+	}
+}
+
 type UpsertMaterialRequest struct {
+	ID            *UUID    `json:"id"`
 	Number        *string  `json:"number"`
 	Plant         string   `json:"plant"`
 	Type          string   `json:"type"`
 	UoM           string   `json:"uom"`
 	Manufacturer  *string  `json:"manufacturer"`
+	PartNumber    *string  `json:"partNumber"`
 	Group         string   `json:"group"`
 	EquipmentCode *string  `json:"equipmentCode"`
 	ShortText     *string  `json:"shortText"`
@@ -241,6 +324,32 @@ type UpsertMaterialRequest struct {
 }
 
 func (r UpsertMaterialRequest) Validate(isNew bool) error {
+	messages := r.validateFields(isNew)
+
+	if len(r.Attachments) == 0 {
+		messages = append(messages, "material attachments are required")
+	}
+
+	if len(r.Attachments) > 2 {
+		messages = append(messages, "maximum number of attachments is two")
+	}
+
+	if len(messages) > 0 {
+		return errors.New(strings.Join(messages, ", "))
+	}
+
+	return nil
+}
+
+func (r UpsertMaterialRequest) ValidateBulk(isNew bool) error {
+	if messages := r.validateFields(isNew); len(messages) > 0 {
+		return errors.New(strings.Join(messages, ", "))
+	}
+
+	return nil
+}
+
+func (r UpsertMaterialRequest) validateFields(isNew bool) []string {
 	messages := make([]string, 0, 5)
 
 	if isNew && r.Number != nil {
@@ -271,19 +380,11 @@ func (r UpsertMaterialRequest) Validate(isNew bool) error {
 		messages = append(messages, "material long text is required")
 	}
 
-	if len(r.Attachments) == 0 {
-		messages = append(messages, "material attachments are required")
-	}
-
-	if len(r.Attachments) > 2 {
-		messages = append(messages, "maximum number of attachments is two")
-	}
-
-	if len(messages) > 0 {
-		return errors.New(strings.Join(messages, ", "))
+	if r.PartNumber != nil && len(*r.PartNumber) > 255 {
+		messages = append(messages, "maximum length of material part number is 255 characters")
 	}
 
-	return nil
+	return messages
 }
 
 type UpsertMaterialTypeRequest struct {
@@ -297,26 +398,26 @@ func (r *UpsertMaterialTypeRequest) Validate() error {
 		return errors.New("missing request object")
 	}
 
-	messages := make([]string, 0, 5)
+	fieldErrors := make(FieldErrors, 0, 5)
 
 	if len(r.Code) == 0 {
-		messages = append(messages, "material type's code is required")
+		fieldErrors = append(fieldErrors, FieldError{Field: "code", Message: "material type's code is required"})
 	}
 
 	if len(r.Code) > 250 {
-		messages = append(messages, "material type's code is too long")
+		fieldErrors = append(fieldErrors, FieldError{Field: "code", Message: "material type's code is too long"})
 	}
 
 	if len(r.Description) == 0 {
-		messages = append(messages, "material type's description is required")
+		fieldErrors = append(fieldErrors, FieldError{Field: "description", Message: "material type's description is required"})
 	}
 
 	if len(r.Description) > 1000 {
-		messages = append(messages, "material type's description is too long")
+		fieldErrors = append(fieldErrors, FieldError{Field: "description", Message: "material type's description is too long"})
 	}
 
-	if len(messages) > 0 {
-		return errors.New(strings.Join(messages, ","))
+	if len(fieldErrors) > 0 {
+		return fieldErrors
 	}
 
 	return nil
@@ -340,26 +441,26 @@ func (r *UpsertMaterialUoMRequest) Validate() error {
 		return errors.New("missing request object")
 	}
 
-	messages := make([]string, 0, 5)
+	fieldErrors := make(FieldErrors, 0, 5)
 
 	if len(r.Code) == 0 {
-		messages = append(messages, "material uom's code is required")
+		fieldErrors = append(fieldErrors, FieldError{Field: "code", Message: "material uom's code is required"})
 	}
 
 	if len(r.Code) > 250 {
-		messages = append(messages, "material uom's code is too long")
+		fieldErrors = append(fieldErrors, FieldError{Field: "code", Message: "material uom's code is too long"})
 	}
 
 	if len(r.Description) == 0 {
-		messages = append(messages, "material uom's description is required")
+		fieldErrors = append(fieldErrors, FieldError{Field: "description", Message: "material uom's description is required"})
 	}
 
 	if len(r.Description) > 1000 {
-		messages = append(messages, "material uom's description is too long")
+		fieldErrors = append(fieldErrors, FieldError{Field: "description", Message: "material uom's description is too long"})
 	}
 
-	if len(messages) > 0 {
-		return errors.New(strings.Join(messages, ","))
+	if len(fieldErrors) > 0 {
+		return fieldErrors
 	}
 
 	return nil
@@ -382,26 +483,26 @@ func (r *UpsertMaterialGroupRequest) Validate() error {
 		return errors.New("missing request object")
 	}
 
-	messages := make([]string, 0, 5)
+	fieldErrors := make(FieldErrors, 0, 5)
 
 	if len(r.Code) == 0 {
-		messages = append(messages, "material group's code is required")
+		fieldErrors = append(fieldErrors, FieldError{Field: "code", Message: "material group's code is required"})
 	}
 
 	if len(r.Code) > 250 {
-		messages = append(messages, "material group's code is too long")
+		fieldErrors = append(fieldErrors, FieldError{Field: "code", Message: "material group's code is too long"})
 	}
 
 	if len(r.Description) == 0 {
-		messages = append(messages, "material group's description is required")
+		fieldErrors = append(fieldErrors, FieldError{Field: "description", Message: "material group's description is required"})
 	}
 
 	if len(r.Description) > 1000 {
-		messages = append(messages, "material group's description is too long")
+		fieldErrors = append(fieldErrors, FieldError{Field: "description", Message: "material group's description is too long"})
 	}
 
-	if len(messages) > 0 {
-		return errors.New(strings.Join(messages, ","))
+	if len(fieldErrors) > 0 {
+		return fieldErrors
 	}
 
 	return nil
@@ -424,26 +525,26 @@ func (r *UpsertPlantRequest) Validate() error {
 		return errors.New("missing request object")
 	}
 
-	messages := make([]string, 0, 5)
+	fieldErrors := make(FieldErrors, 0, 5)
 
 	if len(r.Code) == 0 {
-		messages = append(messages, "plant's code is required")
+		fieldErrors = append(fieldErrors, FieldError{Field: "code", Message: "plant's code is required"})
 	}
 
 	if len(r.Code) > 250 {
-		messages = append(messages, "plant's code is too long")
+		fieldErrors = append(fieldErrors, FieldError{Field: "code", Message: "plant's code is too long"})
 	}
 
 	if len(r.Description) == 0 {
-		messages = append(messages, "plant's description is required")
+		fieldErrors = append(fieldErrors, FieldError{Field: "description", Message: "plant's description is required"})
 	}
 
 	if len(r.Description) > 1000 {
-		messages = append(messages, "plant's description is too long")
+		fieldErrors = append(fieldErrors, FieldError{Field: "description", Message: "plant's description is too long"})
 	}
 
-	if len(messages) > 0 {
-		return errors.New(strings.Join(messages, ","))
+	if len(fieldErrors) > 0 {
+		return fieldErrors
 	}
 
 	return nil
@@ -466,26 +567,26 @@ func (r *UpsertManufacturerRequest) Validate() error {
 		return errors.New("missing request object")
 	}
 
-	messages := make([]string, 0, 5)
+	fieldErrors := make(FieldErrors, 0, 5)
 
 	if len(r.Code) == 0 {
-		messages = append(messages, "manufacturer's code is required")
+		fieldErrors = append(fieldErrors, FieldError{Field: "code", Message: "manufacturer's code is required"})
 	}
 
 	if len(r.Code) > 250 {
-		messages = append(messages, "manufacturer's code is too long")
+		fieldErrors = append(fieldErrors, FieldError{Field: "code", Message: "manufacturer's code is too long"})
 	}
 
 	if len(r.Description) == 0 {
-		messages = append(messages, "manufacturer's description is required")
+		fieldErrors = append(fieldErrors, FieldError{Field: "description", Message: "manufacturer's description is required"})
 	}
 
 	if len(r.Description) > 1000 {
-		messages = append(messages, "manufacturer's description is too long")
+		fieldErrors = append(fieldErrors, FieldError{Field: "description", Message: "manufacturer's description is too long"})
 	}
 
-	if len(messages) > 0 {
-		return errors.New(strings.Join(messages, ","))
+	if len(fieldErrors) > 0 {
+		return fieldErrors
 	}
 
 	return nil
@@ -498,6 +599,62 @@ func (r UpsertManufacturerRequest) Model() Manufacturer {
 	}
 }
 
+type PlantMaterialType struct {
+	PlantCode string `json:"plantCode"`
+	TypeCode  string `json:"typeCode"`
+}
+
+type CreatePlantMaterialTypeRequest struct {
+	TypeCode string `json:"typeCode"`
+}
+
+func (r *CreatePlantMaterialTypeRequest) Validate() error {
+	if r == nil {
+		return errors.New("missing request object")
+	}
+
+	if len(r.TypeCode) == 0 {
+		return errors.New("material type's code is required")
+	}
+
+	return nil
+}
+
+func (r CreatePlantMaterialTypeRequest) Model(plantCode string) PlantMaterialType {
+	return PlantMaterialType{
+		PlantCode: plantCode,
+		TypeCode:  r.TypeCode,
+	}
+}
+
+type MaterialTypeGroup struct {
+	TypeCode  string `json:"typeCode"`
+	GroupCode string `json:"groupCode"`
+}
+
+type CreateMaterialTypeGroupRequest struct {
+	GroupCode string `json:"groupCode"`
+}
+
+func (r *CreateMaterialTypeGroupRequest) Validate() error {
+	if r == nil {
+		return errors.New("missing request object")
+	}
+
+	if len(r.GroupCode) == 0 {
+		return errors.New("material group's code is required")
+	}
+
+	return nil
+}
+
+func (r CreateMaterialTypeGroupRequest) Model(typeCode string) MaterialTypeGroup {
+	return MaterialTypeGroup{
+		TypeCode:  typeCode,
+		GroupCode: r.GroupCode,
+	}
+}
+
 type ListMaterialTypesCriteria struct {
 	FilterMaterialType
 	Sort
@@ -547,3 +704,19 @@ type ListManufacturersCriteria struct {
 type FilterManufacturer struct {
 	Description string
 }
+
+type ListMaterialsCriteria struct {
+	FilterMaterial
+	Sort
+	Page
+}
+
+type FilterMaterial struct {
+	Number           string
+	PlantCode        string
+	TypeCode         string
+	GroupCode        string
+	ManufacturerCode string
+	Status           Status
+	Query            string
+}
@@ -0,0 +1,25 @@
+package model
+
+import "testing"
+
+func TestUpsertMaterialRequestValidate(t *testing.T) {
+	material := UpsertMaterialRequest{Plant: "1000", Type: "ERSA", UoM: "PC", Group: "G001", LongText: "BEARING, BALL; 6205-2RS"}
+
+	if err := material.Validate(true); err == nil {
+		t.Errorf("Validate() without attachments returns nil error")
+	}
+
+	if err := material.ValidateBulk(true); err != nil {
+		t.Errorf("ValidateBulk() without attachments error = %v", err)
+	}
+
+	material.Attachments = []string{"dummy-asset-id"}
+	if err := material.Validate(true); err != nil {
+		t.Errorf("Validate() with attachment error = %v", err)
+	}
+
+	material.LongText = ""
+	if err := material.ValidateBulk(true); err == nil {
+		t.Errorf("ValidateBulk() without long text returns nil error")
+	}
+}
@@ -1,21 +1,31 @@
 package model
 
 import (
+	"cmp"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"html"
+	"slices"
 	"strings"
+	"time"
+
+	"github.com/dev-pt-bai/cataloging/internal/pkg/async/manager"
 )
 
 type Request struct {
-	ID          UUID       `json:"id"`
-	Subject     string     `json:"subject"`
-	IsNew       Flag       `json:"isNew"`
-	RequestedBy User       `json:"requestedBy"`
-	Status      Status     `json:"status"`
-	CreatedAt   int64      `json:"createdAt"`
-	UpdatedAt   int64      `json:"updatedAt"`
-	Materials   []Material `json:"materials"`
+	ID          UUID             `json:"id"`
+	Subject     string           `json:"subject"`
+	IsNew       Flag             `json:"isNew"`
+	RequestedBy User             `json:"requestedBy"`
+	AssignedTo  *User            `json:"assignedTo"`
+	Status      Status           `json:"status"`
+	DueAt       *int64           `json:"dueAt"`
+	EscalatedAt *int64           `json:"escalatedAt"`
+	CreatedAt   int64            `json:"createdAt"`
+	UpdatedAt   int64            `json:"updatedAt"`
+	Materials   []Material       `json:"materials"`
+	History     []RequestHistory `json:"history"`
 }
 
 func (r *Request) Scan(src any) error {
@@ -28,9 +38,228 @@ func (r *Request) Scan(src any) error {
 		return fmt.Errorf("failed to convert src of type [%T] to []byte", src)
 	}
 
+	if err := json.Unmarshal(b, r); err != nil {
+		return err
+	}
+
+	slices.SortStableFunc(r.History, func(a, b RequestHistory) int {
+		return cmp.Compare(a.CreatedAt, b.CreatedAt)
+	})
+
+	return nil
+}
+
+type RequestHistory struct {
+	ID         UUID    `json:"id"`
+	RequestID  UUID    `json:"requestID"`
+	FromStatus *Status `json:"fromStatus"`
+	ToStatus   Status  `json:"toStatus"`
+	Actor      User    `json:"actor"`
+	Reason     *string `json:"reason"`
+	CreatedAt  int64   `json:"createdAt"`
+}
+
+func NewRequestHistory(requestID UUID, from *Status, to Status, actor *Auth, reason *string) RequestHistory {
+	return RequestHistory{
+		ID:         NewUUID(),
+		RequestID:  requestID,
+		FromStatus: from,
+		ToStatus:   to,
+		Actor:      User{ID: actor.UserID, Email: actor.UserEmail},
+		Reason:     reason,
+	}
+}
+
+type ReviewDecision string
+
+const (
+	AcceptMaterial ReviewDecision = "accept"
+	RejectMaterial ReviewDecision = "reject"
+	ReturnMaterial ReviewDecision = "return"
+)
+
+var ReviewReasonCodes = map[string]string{
+	"INCOMPLETE_DATA":      "Data material tidak lengkap",
+	"INVALID_ATTACHMENT":   "Lampiran tidak sesuai",
+	"DUPLICATE":            "Material sudah terdaftar",
+	"WRONG_CLASSIFICATION": "Klasifikasi material tidak sesuai",
+	"NOT_STOCK_ITEM":       "Bukan material persediaan",
+	"OTHER":                "Lainnya",
+}
+
+type MaterialReview struct {
+	MaterialID UUID           `json:"-"`
+	Decision   ReviewDecision `json:"decision"`
+	ReasonCode *string        `json:"reasonCode"`
+	Note       *string        `json:"note"`
+	ReviewedBy string         `json:"reviewedBy"`
+	ReviewedAt int64          `json:"reviewedAt"`
+}
+
+type reviewRule struct {
+	accepted Status
+	roles    []Role
+}
+
+var reviewRules = map[Status]reviewRule{
+	Submitted: {accepted: Processed, roles: []Role{Cataloger, Administrator}},
+	Processed: {accepted: Approved, roles: []Role{Approver, Administrator}},
+}
+
+func (s Status) IsReviewable() bool {
+	_, exists := reviewRules[s]
+	return exists
+}
+
+func (s Status) CanBeReviewedBy(role Role) bool {
+	return slices.Contains(reviewRules[s].roles, role)
+}
+
+func (d ReviewDecision) MaterialStatus(stage Status) Status {
+	switch d {
+	case RejectMaterial:
+		return Rejected
+	case ReturnMaterial:
+		return Draft
+	default:
+		return reviewRules[stage].accepted
+	}
+}
+
+func DeriveRequestStatus(stage Status, materials []Status) (Status, bool) {
+	if len(materials) == 0 {
+		return stage, false
+	}
+
+	var accepted, rejected, returned int
+	for i := range materials {
+		switch materials[i] {
+		case stage:
+			return stage, false
+		case Rejected:
+			rejected++
+		case Draft:
+			returned++
+		default:
+			accepted++
+		}
+	}
+
+	switch {
+	case returned > 0:
+		return Draft, true
+	case accepted == 0:
+		return Rejected, true
+	case stage == Processed && rejected > 0:
+		return PartiallyApproved, true
+	default:
+		return reviewRules[stage].accepted, true
+	}
+}
+
+type ReviewMaterialRequest struct {
+	Decision   ReviewDecision `json:"decision"`
+	ReasonCode *string        `json:"reasonCode"`
+	Note       *string        `json:"note"`
+}
+
+func (r *ReviewMaterialRequest) Validate() error {
+	if r == nil {
+		return errors.New("missing request object")
+	}
+
+	messages := make([]string, 0, 5)
+
+	switch r.Decision {
+	case AcceptMaterial, RejectMaterial, ReturnMaterial:
+	default:
+		messages = append(messages, fmt.Sprintf("unknown review decision: %s", r.Decision))
+	}
+
+	if r.Decision != AcceptMaterial && (r.ReasonCode == nil || len(*r.ReasonCode) == 0) {
+		messages = append(messages, "review reason code is required")
+	}
+
+	if r.ReasonCode != nil {
+		if _, exists := ReviewReasonCodes[*r.ReasonCode]; !exists {
+			messages = append(messages, fmt.Sprintf("unknown review reason code: %s", *r.ReasonCode))
+		}
+	}
+
+	if r.Note != nil && len(*r.Note) > 1023 {
+		messages = append(messages, "maximum length of review note is 1023 characters")
+	}
+
+	if len(messages) > 0 {
+		return errors.New(strings.Join(messages, ", "))
+	}
+
+	return nil
+}
+
+func (r ReviewMaterialRequest) Model(materialID UUID, reviewedBy *Auth) MaterialReview {
+	return MaterialReview{
+		MaterialID: materialID,
+		Decision:   r.Decision,
+		ReasonCode: r.ReasonCode,
+		Note:       r.Note,
+		ReviewedBy: reviewedBy.UserID,
+	}
+}
+
+func (r Request) IsAssignedTo(actor *Auth) bool {
+	return r.AssignedTo != nil && actor != nil && r.AssignedTo.ID == actor.UserID
+}
+
+func (r Request) RequiresAssignment(actor *Auth) bool {
+	return r.Status == Submitted && actor != nil && actor.Role == Cataloger
+}
+
+type AssignRequestRequest struct {
+	UserID string `json:"userID"`
+}
+
+func (r *AssignRequestRequest) Validate() error {
+	if r == nil {
+		return errors.New("missing request object")
+	}
+
+	if len(r.UserID) == 0 {
+		return errors.New("assignee user ID is required")
+	}
+
+	return nil
+}
+
+type Requests struct {
+	Data  []*Request `json:"data"`
+	Count int64      `json:"count"`
+}
+
+func (r *Requests) Scan(src any) error {
+	if src == nil {
+		return nil
+	}
+
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to convert src of type [%T] to []byte", src)
+	}
+
 	return json.Unmarshal(b, r)
 }
 
+func (r *Requests) Response(page Page) List {
+	if r == nil {
+		return List{}
+	}
+
+	return List{
+		Data: r.Data,
+		Meta: meta(r.Count, page.ItemPerPage, page.Number),
+	}
+}
+
 type Status int
 
 const (
@@ -41,6 +270,8 @@ const (
 	Approved
 	Published
 	Deprecated
+	Submitted
+	PartiallyApproved
 )
 
 func (s Status) MarshalJSON() ([]byte, error) {
@@ -60,11 +291,132 @@ func (s Status) MarshalJSON() ([]byte, error) {
 		status = "Published"
 	case Deprecated:
 		status = "Deprecated"
+	case Submitted:
+		status = "Submitted"
+	case PartiallyApproved:
+		status = "PartiallyApproved"
 	}
 
 	return json.Marshal(status)
 }
 
+func (s Status) IsValid() bool {
+	return s >= Draft && s <= PartiallyApproved
+}
+
+func StatusFromStr(s string) Status {
+	switch strings.ToLower(s) {
+	default:
+		return 0
+	case "draft":
+		return Draft
+	case "processed":
+		return Processed
+	case "rejected":
+		return Rejected
+	case "approved":
+		return Approved
+	case "published":
+		return Published
+	case "deprecated":
+		return Deprecated
+	case "submitted":
+		return Submitted
+	case "partiallyapproved":
+		return PartiallyApproved
+	}
+}
+
+func (s Status) MaterialStatus() Status {
+	if s == PartiallyApproved {
+		return Approved
+	}
+
+	return s
+}
+
+type Transition string
+
+const (
+	Submit    Transition = "submit"
+	Process   Transition = "process"
+	Approve   Transition = "approve"
+	Reject    Transition = "reject"
+	Publish   Transition = "publish"
+	Deprecate Transition = "deprecate"
+)
+
+type transitionRule struct {
+	to        Status
+	from      map[Status][]Role
+	ownerOnly bool
+}
+
+var transitionRules = map[Transition]transitionRule{
+	Submit: {
+		to:        Submitted,
+		from:      map[Status][]Role{Draft: {Requester, Cataloger, Approver, Administrator}},
+		ownerOnly: true,
+	},
+	Process: {
+		to:   Processed,
+		from: map[Status][]Role{Submitted: {Cataloger, Administrator}},
+	},
+	Approve: {
+		to:   Approved,
+		from: map[Status][]Role{Processed: {Approver, Administrator}},
+	},
+	Reject: {
+		to: Rejected,
+		from: map[Status][]Role{
+			Submitted: {Cataloger, Administrator},
+			Processed: {Approver, Administrator},
+		},
+	},
+	Publish: {
+		to: Published,
+		from: map[Status][]Role{
+			Approved:          {Cataloger, Administrator},
+			PartiallyApproved: {Cataloger, Administrator},
+		},
+	},
+	Deprecate: {
+		to:   Deprecated,
+		from: map[Status][]Role{Published: {Administrator}},
+	},
+}
+
+func (t Transition) Target() (Status, bool) {
+	rule, exists := transitionRules[t]
+	return rule.to, exists
+}
+
+func (t Transition) IsAllowedFrom(s Status) bool {
+	_, exists := transitionRules[t].from[s]
+	return exists
+}
+
+func (r Request) IsVisibleTo(a *Auth) bool {
+	if a == nil {
+		return false
+	}
+
+	return r.RequestedBy.ID == a.UserID || a.IsReviewer()
+}
+
+func (t Transition) IsPermitted(s Status, actor *Auth, owner string) bool {
+	rule, exists := transitionRules[t]
+	if !exists || actor == nil {
+		return false
+	}
+
+	if rule.ownerOnly && actor.UserID != owner && !actor.IsAdmin() {
+		return false
+	}
+
+	return slices.Contains(rule.from[s], actor.Role)
+}
+
 type UpsertRequestRequest struct {
 	Subject   string                  `json:"subject"`
 	IsNew     *bool                   `json:"isNew"`
@@ -124,7 +476,12 @@ func (r UpsertRequestRequest) Model(ID *UUID, status Status, requestedBy *Auth)
 			materials := make([]Material, 0, 5)
 			for i := range umrs {
 				materials = append(materials, Material{
-					ID:     NewUUID(),
+					ID: func(id *UUID) UUID {
+						if id == nil {
+							return NewUUID()
+						}
+						return *id
+					}(umrs[i].ID),
 					Plant:  Plant{Code: umrs[i].Plant},
 					Number: umrs[i].Number,
 					Type:   MaterialType{Code: umrs[i].Type},
@@ -135,6 +492,7 @@ func (r UpsertRequestRequest) Model(ID *UUID, status Status, requestedBy *Auth)
 						}
 						return &Manufacturer{Code: *code}
 					}(umrs[i].Manufacturer),
+					PartNumber:    umrs[i].PartNumber,
 					Group:         MaterialGroup{Code: umrs[i].Group},
 					EquipmentCode: umrs[i].EquipmentCode,
 					ShortText:     umrs[i].ShortText,
@@ -155,17 +513,277 @@ func (r UpsertRequestRequest) Model(ID *UUID, status Status, requestedBy *Auth)
 	}
 }
 
-func (r Request) NewNotificationEmail() *Email {
-	switch r.Status {
-	case Draft:
-		return nil
+type BulkCreateRequestRequest struct {
+	Subject string
+	IsNew   bool
+}
+
+func (r *BulkCreateRequestRequest) Validate() error {
+	if r == nil {
+		return errors.New("missing request object")
+	}
+
+	if len(r.Subject) == 0 {
+		return errors.New("request subject is required")
+	}
+
+	if len(r.Subject) > 255 {
+		return errors.New("maximum length of request subject is 255 characters")
+	}
+
+	return nil
+}
+
+type SpreadsheetRowError struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
+
+type requestNotification struct {
+	subject          string
+	heading          string
+	requesterMessage string
+	reviewerMessage  string
+}
+
+var requestNotifications = map[Status]requestNotification{
+	Draft: {
+		subject:          "Permintaan Dikembalikan",
+		heading:          "Permintaan Anda perlu diperbaiki",
+		requesterMessage: "Permintaan <b>%s</b> dengan nomor %v dikembalikan oleh tim katalog. Silakan perbaiki material yang dikembalikan lalu ajukan kembali.",
+	},
+	Submitted: {
+		subject:          "Permintaan Diajukan",
+		heading:          "Permintaan telah diajukan",
+		requesterMessage: "Permintaan <b>%s</b> dengan nomor %v berhasil diajukan dan sedang menunggu proses oleh tim katalog.",
+		reviewerMessage:  "Permintaan <b>%s</b> dengan nomor %v telah diajukan dan menunggu untuk Anda proses.",
+	},
+	Processed: {
+		subject:          "Permintaan Sedang Diproses",
+		heading:          "Permintaan telah diproses",
+		requesterMessage: "Permintaan <b>%s</b> dengan nomor %v telah diproses oleh tim katalog dan sedang menunggu persetujuan.",
+		reviewerMessage:  "Permintaan <b>%s</b> dengan nomor %v telah diproses oleh tim katalog dan menunggu persetujuan Anda.",
+	},
+	Rejected: {
+		subject:          "Permintaan Ditolak",
+		heading:          "Permintaan Anda ditolak",
+		requesterMessage: "Mohon maaf, permintaan <b>%s</b> dengan nomor %v ditolak.",
+	},
+	Approved: {
+		subject:          "Permintaan Disetujui",
+		heading:          "Permintaan Anda telah disetujui",
+		requesterMessage: "Permintaan <b>%s</b> dengan nomor %v telah disetujui dan akan segera didaftarkan ke SAP.",
+	},
+	PartiallyApproved: {
+		subject:          "Permintaan Disetujui Sebagian",
+		heading:          "Sebagian permintaan Anda telah disetujui",
+		requesterMessage: "Sebagian material pada permintaan <b>%s</b> dengan nomor %v telah disetujui dan akan segera didaftarkan ke SAP. Material lainnya ditolak.",
+	},
+	Published: {
+		subject:          "Material Telah Terdaftar",
+		heading:          "Material Anda telah terdaftar di SAP",
+		requesterMessage: "Material pada permintaan <b>%s</b> dengan nomor %v telah terdaftar di SAP dengan nomor material berikut:",
+	},
+	Deprecated: {
+		subject:          "Material Tidak Berlaku",
+		heading:          "Material Anda tidak lagi berlaku",
+		requesterMessage: "Material pada permintaan <b>%s</b> dengan nomor %v telah dinyatakan tidak berlaku.",
+	},
+}
+
+func (s Status) NotifiedRole() Role {
+	switch s {
+	case Submitted:
+		return Cataloger
 	case Processed:
-		return NewTextEmail(
-			"[Cataloging] Permintaan Sedang Diproses",
-			fmt.Sprintf("Permintaan dengan nomor %v berhasil didaftarkan pada sistem dan berstatus sedang dalam proses", r.ID),
-			r.RequestedBy.Email,
-		)
+		return Approver
 	default:
+		return 0
+	}
+}
+
+func (s Status) IsNotified() bool {
+	_, exists := requestNotifications[s]
+	return exists
+}
+
+func (r Request) NewNotificationEmail(recipient User, appBaseURL string) *Email {
+	n, exists := requestNotifications[r.Status]
+	if !exists {
 		return nil
 	}
+
+	message := n.reviewerMessage
+	if recipient.ID == r.RequestedBy.ID || len(message) == 0 {
+		message = n.requesterMessage
+	}
+
+	return NewHTMLEmail(
+		fmt.Sprintf("[Cataloging] %s", n.subject),
+		fmt.Sprintf(emailRequestStatus, n.heading, recipient.Name, fmt.Sprintf(message, html.EscapeString(r.Subject), r.ID), r.notificationDetails(), appBaseURL),
+		recipient.Email,
+	)
+}
+
+func (r Request) NewEscalationEmail(recipient User, appBaseURL string) *Email {
+	assignee := "-"
+	if r.AssignedTo != nil {
+		assignee = r.AssignedTo.Name
+	}
+
+	var dueAt time.Time
+	if r.DueAt != nil {
+		dueAt = time.Unix(*r.DueAt, 0).UTC().Add(7 * time.Hour)
+	}
+
+	status, _ := r.Status.MarshalJSON()
+
+	return NewHTMLEmail(
+		"[Cataloging] Permintaan Melewati Batas Waktu",
+		fmt.Sprintf(emailEscalation, recipient.Name, html.EscapeString(r.Subject), r.ID, strings.Trim(string(status), `"`), assignee, fmt.Sprintf(dueAt.Format("02 %s 2006 15:04"), indonesianMonth[dueAt.Month()]), appBaseURL),
+		recipient.Email,
+	)
+}
+
+func (r Request) notificationDetails() string {
+	b := new(strings.Builder)
+
+	switch r.Status {
+	case Draft, Rejected:
+		for i := len(r.History) - 1; i >= 0; i-- {
+			if r.History[i].ToStatus == r.Status && r.History[i].Reason != nil {
+				fmt.Fprintf(b, `<div class="detail-box">Alasan: %s</div>`, html.EscapeString(*r.History[i].Reason))
+				break
+			}
+		}
+		for i := range r.Materials {
+			m := r.Materials[i]
+			if m.Review == nil || m.Review.Decision == AcceptMaterial || m.Review.ReasonCode == nil {
+				continue
+			}
+			fmt.Fprintf(b, `<div class="detail-box">%s: %s</div>`, html.EscapeString(m.LongText), ReviewReasonCodes[*m.Review.ReasonCode])
+		}
+	case Published:
+		b.WriteString(`<table class="detail-table"><tr><th>Nomor Material</th><th>Deskripsi</th></tr>`)
+		for i := range r.Materials {
+			m := r.Materials[i]
+			if m.Number == nil || m.Status != Published {
+				continue
+			}
+			fmt.Fprintf(b, `<tr><td>%s</td><td>%s</td></tr>`, html.EscapeString(*m.Number), html.EscapeString(m.LongText))
+		}
+		b.WriteString(`</table>`)
+	}
+
+	return b.String()
+}
+
+type ListRequestsCriteria struct {
+	FilterRequest
+	Sort
+	Page
+}
+
+type FilterRequest struct {
+	Status      Status
+	RequestedBy string
+	AssignedTo  string
+	IsNew       *Flag
+	Subject     string
+	CreatedFrom int64
+	CreatedTo   int64
+	PlantCode   string
+	GroupCode   string
+}
+
+type TransitRequestRequest struct {
+	Reason *string `json:"reason"`
+}
+
+func (r *TransitRequestRequest) Validate(transition Transition) error {
+	if r == nil {
+		return errors.New("missing request object")
+	}
+
+	if transition == Reject && (r.Reason == nil || len(strings.TrimSpace(*r.Reason)) == 0) {
+		return errors.New("rejection reason is required")
+	}
+
+	if r.Reason != nil && len(*r.Reason) > 1023 {
+		return errors.New("maximum length of reason is 1023 characters")
+	}
+
+	return nil
+}
+
+func (r Request) IsExportable() bool {
+	return r.Status == Approved || r.Status == PartiallyApproved
+}
+
+func (r Request) ExportableMaterials() []Material {
+	materials := make([]Material, 0, len(r.Materials))
+	for i := range r.Materials {
+		if r.Materials[i].Status == Approved {
+			materials = append(materials, r.Materials[i])
+		}
+	}
+
+	return materials
+}
+
+type ExportIDocRequest struct {
+	RequestIDs []UUID `json:"requestIDs"`
+}
+
+func (r *ExportIDocRequest) Validate() error {
+	if r == nil {
+		return errors.New("missing request object")
+	}
+
+	messages := make([]string, 0, 5)
+
+	if len(r.RequestIDs) == 0 {
+		messages = append(messages, "request IDs are required")
+	}
+
+	if len(r.RequestIDs) > 50 {
+		messages = append(messages, "maximum number of request IDs is 50")
+	}
+
+	if len(messages) > 0 {
+		return errors.New(strings.Join(messages, ", "))
+	}
+
+	return nil
+}
+
+type PublishRequestTask struct {
+	RequestID  UUID    `json:"requestID"`
+	ActorID    string  `json:"actorID"`
+	ActorEmail string  `json:"actorEmail"`
+	Reason     *string `json:"reason"`
+}
+
+func NewPublishRequestTask(requestID UUID, actor *Auth, reason *string) PublishRequestTask {
+	return PublishRequestTask{
+		RequestID:  requestID,
+		ActorID:    actor.UserID,
+		ActorEmail: actor.UserEmail,
+		Reason:     reason,
+	}
+}
+
+func (t PublishRequestTask) Actor() *Auth {
+	return &Auth{UserID: t.ActorID, UserEmail: t.ActorEmail}
+}
+
+func (t PublishRequestTask) NewTask(taskType string) *manager.Task {
+	data, _ := json.Marshal(t)
+
+	return &manager.Task{
+		ID:         NewUUID().String(),
+		Type:       taskType,
+		Data:       data,
+		RetryCount: 0,
+	}
 }
@@ -0,0 +1,259 @@
+package model
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTransitionIsPermitted(t *testing.T) {
+	owner := "dummy-owner"
+
+	type args struct {
+		transition Transition
+		status     Status
+		actor      *Auth
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "owner submits draft",
+			args: args{transition: Submit, status: Draft, actor: &Auth{UserID: owner, Role: Requester}},
+			want: true,
+		},
+		{
+			name: "non-owner requester submits draft",
+			args: args{transition: Submit, status: Draft, actor: &Auth{UserID: "dummy-other", Role: Requester}},
+			want: false,
+		},
+		{
+			name: "administrator submits draft of others",
+			args: args{transition: Submit, status: Draft, actor: &Auth{UserID: "dummy-admin", Role: Administrator}},
+			want: true,
+		},
+		{
+			name: "cataloger processes submitted request",
+			args: args{transition: Process, status: Submitted, actor: &Auth{UserID: "dummy-cataloger", Role: Cataloger}},
+			want: true,
+		},
+		{
+			name: "requester processes submitted request",
+			args: args{transition: Process, status: Submitted, actor: &Auth{UserID: owner, Role: Requester}},
+			want: false,
+		},
+		{
+			name: "cataloger approves processed request",
+			args: args{transition: Approve, status: Processed, actor: &Auth{UserID: "dummy-cataloger", Role: Cataloger}},
+			want: false,
+		},
+		{
+			name: "approver rejects processed request",
+			args: args{transition: Reject, status: Processed, actor: &Auth{UserID: "dummy-approver", Role: Approver}},
+			want: true,
+		},
+		{
+			name: "approver rejects submitted request",
+			args: args{transition: Reject, status: Submitted, actor: &Auth{UserID: "dummy-approver", Role: Approver}},
+			want: false,
+		},
+		{
+			name: "missing actor",
+			args: args{transition: Publish, status: Approved},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.args.transition.IsPermitted(tt.args.status, tt.args.actor, owner); got != tt.want {
+				t.Errorf("IsPermitted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequestIsVisibleTo(t *testing.T) {
+	request := Request{RequestedBy: User{ID: "dummy-owner"}}
+
+	tests := []struct {
+		name string
+		auth *Auth
+		want bool
+	}{
+		{name: "owner", auth: &Auth{UserID: "dummy-owner", Role: Requester}, want: true},
+		{name: "other requester", auth: &Auth{UserID: "dummy-other", Role: Requester}, want: false},
+		{name: "cataloger", auth: &Auth{UserID: "dummy-cataloger", Role: Cataloger}, want: true},
+		{name: "approver", auth: &Auth{UserID: "dummy-approver", Role: Approver}, want: true},
+		{name: "administrator", auth: &Auth{UserID: "dummy-admin", Role: Administrator}, want: true},
+		{name: "missing auth", auth: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := request.IsVisibleTo(tt.auth); got != tt.want {
+				t.Errorf("IsVisibleTo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransitionIsAllowedFrom(t *testing.T) {
+	tests := []struct {
+		name       string
+		transition Transition
+		status     Status
+		want       bool
+	}{
+		{name: "submit draft", transition: Submit, status: Draft, want: true},
+		{name: "submit processed", transition: Submit, status: Processed, want: false},
+		{name: "reject submitted", transition: Reject, status: Submitted, want: true},
+		{name: "reject approved", transition: Reject, status: Approved, want: false},
+		{name: "deprecate published", transition: Deprecate, status: Published, want: true},
+		{name: "unknown transition", transition: Transition("dummy"), status: Draft, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.transition.IsAllowedFrom(tt.status); got != tt.want {
+				t.Errorf("IsAllowedFrom() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeriveRequestStatus(t *testing.T) {
+	type result struct {
+		status    Status
+		isDecided bool
+	}
+
+	tests := []struct {
+		name      string
+		stage     Status
+		materials []Status
+		want      result
+	}{
+		{
+			name:      "pending review",
+			stage:     Submitted,
+			materials: []Status{Processed, Submitted},
+			want:      result{status: Submitted, isDecided: false},
+		},
+		{
+			name:      "all accepted by cataloger",
+			stage:     Submitted,
+			materials: []Status{Processed, Processed},
+			want:      result{status: Processed, isDecided: true},
+		},
+		{
+			name:      "some returned",
+			stage:     Submitted,
+			materials: []Status{Processed, Draft, Rejected},
+			want:      result{status: Draft, isDecided: true},
+		},
+		{
+			name:      "all rejected",
+			stage:     Processed,
+			materials: []Status{Rejected, Rejected},
+			want:      result{status: Rejected, isDecided: true},
+		},
+		{
+			name:      "some rejected by approver",
+			stage:     Processed,
+			materials: []Status{Approved, Rejected},
+			want:      result{status: PartiallyApproved, isDecided: true},
+		},
+		{
+			name:      "all approved",
+			stage:     Processed,
+			materials: []Status{Approved, Approved},
+			want:      result{status: Approved, isDecided: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, isDecided := DeriveRequestStatus(tt.stage, tt.materials)
+			if got := (result{status: status, isDecided: isDecided}); got != tt.want {
+				t.Errorf("DeriveRequestStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequestNewNotificationEmail(t *testing.T) {
+	requester := User{ID: "dummy-owner", Name: "Owner", Email: "owner@bai.id"}
+	reason := "datasheet <missing>"
+	reasonCode := "INVALID_ATTACHMENT"
+	published := "10000001"
+	pending := "10000002"
+
+	tests := []struct {
+		name    string
+		request Request
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "rejected request shows the reason and material review reasons",
+			request: Request{
+				Status: Rejected,
+				History: []RequestHistory{
+					{ToStatus: Submitted},
+					{ToStatus: Rejected, Reason: &reason},
+				},
+				Materials: []Material{
+					{LongText: "BEARING, BALL; 6205-2RS", Review: &MaterialReview{Decision: RejectMaterial, ReasonCode: &reasonCode}},
+					{LongText: "GASKET, SPIRAL WOUND", Review: &MaterialReview{Decision: AcceptMaterial}},
+				},
+			},
+			want:    []string{"Alasan: datasheet &lt;missing&gt;", "BEARING, BALL; 6205-2RS: Lampiran tidak sesuai"},
+			notWant: []string{"GASKET, SPIRAL WOUND"},
+		},
+		{
+			name: "published request lists published material numbers",
+			request: Request{
+				Status: Published,
+				Materials: []Material{
+					{Number: &published, LongText: "BEARING, BALL; 6205-2RS", Status: Published},
+					{Number: &pending, LongText: "GASKET, SPIRAL WOUND", Status: Rejected},
+				},
+			},
+			want:    []string{"<td>10000001</td><td>BEARING, BALL; 6205-2RS</td>"},
+			notWant: []string{"10000002"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.request.ID = NewUUID()
+			test.request.Subject = "Bearings"
+			test.request.RequestedBy = requester
+
+			email := test.request.NewNotificationEmail(requester, "https://cataloging.bai.id")
+			if email == nil {
+				t.Fatalf("want: email, got: nil")
+			}
+
+			for _, want := range test.want {
+				if !strings.Contains(email.Message.Body.Content, want) {
+					t.Errorf("want: %v, got: %v", want, email.Message.Body.Content)
+				}
+			}
+
+			for _, notWant := range test.notWant {
+				if strings.Contains(email.Message.Body.Content, notWant) {
+					t.Errorf("want: no %v, got: %v", notWant, email.Message.Body.Content)
+				}
+			}
+		})
+	}
+
+	var unknown Status
+	if unknown.IsNotified() || (Request{Status: unknown}).NewNotificationEmail(requester, "") != nil {
+		t.Errorf("want: no notification for status %d", unknown)
+	}
+}
@@ -0,0 +1,57 @@
+package model
+
+type FieldDiff struct {
+	Field     string  `json:"field"`
+	Old       *string `json:"old"`
+	New       *string `json:"new"`
+	IsChanged bool    `json:"isChanged"`
+}
+
+type MaterialDiff struct {
+	MaterialID UUID        `json:"materialID"`
+	Number     *string     `json:"number"`
+	RevisionOf UUID        `json:"revisionOf"`
+	Changes    []FieldDiff `json:"changes"`
+}
+
+func (m Material) Diff(published Material) MaterialDiff {
+	fields := []struct {
+		name     string
+		old, new *string
+	}{
+		{name: "plant", old: &published.Plant.Code, new: &m.Plant.Code},
+		{name: "type", old: &published.Type.Code, new: &m.Type.Code},
+		{name: "uom", old: &published.UoM.Code, new: &m.UoM.Code},
+		{name: "group", old: &published.Group.Code, new: &m.Group.Code},
+		{name: "manufacturer", old: published.Manufacturer.SafeCode(), new: m.Manufacturer.SafeCode()},
+		{name: "equipmentCode", old: published.EquipmentCode, new: m.EquipmentCode},
+		{name: "shortText", old: published.ShortText, new: m.ShortText},
+		{name: "longText", old: &published.LongText, new: &m.LongText},
+	}
+
+	diff := MaterialDiff{
+		MaterialID: m.ID,
+		Number:     m.Number,
+		RevisionOf: published.ID,
+		Changes:    make([]FieldDiff, 0, len(fields)),
+	}
+
+	for i := range fields {
+		diff.Changes = append(diff.Changes, FieldDiff{
+			Field:     fields[i].name,
+			Old:       fields[i].old,
+			New:       fields[i].new,
+			IsChanged: !equalStringPtr(fields[i].old, fields[i].new),
+		})
+	}
+
+	return diff
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return *a == *b
+}
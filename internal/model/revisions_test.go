@@ -0,0 +1,52 @@
+package model
+
+import "testing"
+
+func TestMaterialDiff(t *testing.T) {
+	equipmentCode := "EQ-01"
+	shortText := "BRG,BALL"
+
+	published := Material{
+		ID:            NewUUID(),
+		Plant:         Plant{Code: "P001"},
+		Type:          MaterialType{Code: "ERSA"},
+		UoM:           MaterialUoM{Code: "EA"},
+		Group:         MaterialGroup{Code: "G01"},
+		Manufacturer:  &Manufacturer{Code: "SKF"},
+		EquipmentCode: &equipmentCode,
+		ShortText:     &shortText,
+		LongText:      "BEARING, BALL",
+	}
+
+	revision := published
+	revision.ID = NewUUID()
+	revision.UoM = MaterialUoM{Code: "PC"}
+	revision.Manufacturer = nil
+	revision.LongText = "BEARING, BALL; 6205-2RS"
+
+	diff := revision.Diff(published)
+	if diff.MaterialID != revision.ID || diff.RevisionOf != published.ID {
+		t.Fatalf("Diff() = %v -> %v, want %v -> %v", diff.MaterialID, diff.RevisionOf, revision.ID, published.ID)
+	}
+
+	want := map[string]bool{
+		"plant":         false,
+		"type":          false,
+		"uom":           true,
+		"group":         false,
+		"manufacturer":  true,
+		"equipmentCode": false,
+		"shortText":     false,
+		"longText":      true,
+	}
+
+	if len(diff.Changes) != len(want) {
+		t.Fatalf("Diff() returns %d fields, want %d", len(diff.Changes), len(want))
+	}
+
+	for _, c := range diff.Changes {
+		if c.IsChanged != want[c.Field] {
+			t.Errorf("Diff() field %s isChanged = %v, want %v", c.Field, c.IsChanged, want[c.Field])
+		}
+	}
+}
@@ -119,6 +119,236 @@ const (
     <div class="footer">&copy; 2025 PT Borneo Alumina Indonesia. Seluruh hak cipta dilindungi undang-undang.</div>
   </div>
 </body>
+</html>`
+	emailComment = `
+<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="UTF-8">
+  <title>New Comment</title>
+  <style>
+    body {
+      font-family: Arial, sans-serif;
+      background-color: #f4f4f7;
+      padding: 0;
+      margin: 0;
+    }
+    .email-container {
+      max-width: 600px;
+      margin: 30px auto;
+      background-color: #ffffff;
+      padding: 30px;
+      border-radius: 8px;
+      box-shadow: 0 2px 5px rgba(0,0,0,0.1);
+    }
+    h2 {
+      color: #333333;
+    }
+    p {
+      font-size: 16px;
+      color: #555555;
+    }
+    .comment-box {
+      background-color: #f0f0f0;
+      padding: 15px;
+      border-left: 4px solid #2b6cb0;
+      border-radius: 6px;
+      margin: 20px 0;
+      font-size: 15px;
+      color: #2d3748;
+      white-space: pre-wrap;
+    }
+    .cta-button {
+      display: inline-block;
+      background-color: #2b6cb0;
+      color: #ffffff;
+      text-decoration: none;
+      padding: 12px 20px;
+      border-radius: 5px;
+      font-weight: bold;
+    }
+    .footer {
+      text-align: center;
+      font-size: 12px;
+      color: #999999;
+      margin-top: 20px;
+    }
+  </style>
+</head>
+<body>
+  <div class="email-container">
+    <h2>Ada komentar baru untuk Anda</h2>
+    <p>Halo, %s</p>
+    <p>%s menambahkan komentar pada permintaan dengan nomor %v:</p>
+    <div class="comment-box">%s</div>
+    <p>Klik untuk melihat dan membalas komentar:</p>
+    <a href="%s" class="cta-button">Lihat permintaan</a>
+    <p>Salam,<br>Aplikasi Cataloging</p>
+    <div class="footer">&copy; 2025 PT Borneo Alumina Indonesia. Seluruh hak cipta dilindungi undang-undang.</div>
+  </div>
+</body>
+</html>`
+	emailRequestStatus = `
+<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="UTF-8">
+  <title>Request Status</title>
+  <style>
+    body {
+      font-family: Arial, sans-serif;
+      background-color: #f4f4f7;
+      padding: 0;
+      margin: 0;
+    }
+    .email-container {
+      max-width: 600px;
+      margin: 30px auto;
+      background-color: #ffffff;
+      padding: 30px;
+      border-radius: 8px;
+      box-shadow: 0 2px 5px rgba(0,0,0,0.1);
+    }
+    h2 {
+      color: #333333;
+    }
+    p {
+      font-size: 16px;
+      color: #555555;
+    }
+    .detail-box {
+      background-color: #f0f0f0;
+      padding: 15px;
+      border-left: 4px solid #2b6cb0;
+      border-radius: 6px;
+      margin: 20px 0;
+      font-size: 15px;
+      color: #2d3748;
+    }
+    .detail-table {
+      width: 100%%;
+      border-collapse: collapse;
+      margin: 20px 0;
+      font-size: 15px;
+      color: #2d3748;
+    }
+    .detail-table th, .detail-table td {
+      border: 1px solid #e2e8f0;
+      padding: 8px;
+      text-align: left;
+    }
+    .detail-table th {
+      background-color: #f0f0f0;
+    }
+    .cta-button {
+      display: inline-block;
+      background-color: #2b6cb0;
+      color: #ffffff;
+      text-decoration: none;
+      padding: 12px 20px;
+      border-radius: 5px;
+      font-weight: bold;
+    }
+    .footer {
+      text-align: center;
+      font-size: 12px;
+      color: #999999;
+      margin-top: 20px;
+    }
+  </style>
+</head>
+<body>
+  <div class="email-container">
+    <h2>%s</h2>
+    <p>Halo, %s</p>
+    <p>%s</p>
+    %s
+    <p>Klik untuk melihat detail permintaan:</p>
+    <a href="%s" class="cta-button">Lihat permintaan</a>
+    <p>Salam,<br>Aplikasi Cataloging</p>
+    <div class="footer">&copy; 2025 PT Borneo Alumina Indonesia. Seluruh hak cipta dilindungi undang-undang.</div>
+  </div>
+</body>
+</html>`
+	emailEscalation = `
+<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="UTF-8">
+  <title>Request Overdue</title>
+  <style>
+    body {
+      font-family: Arial, sans-serif;
+      background-color: #f4f4f7;
+      padding: 0;
+      margin: 0;
+    }
+    .email-container {
+      max-width: 600px;
+      margin: 30px auto;
+      background-color: #ffffff;
+      padding: 30px;
+      border-radius: 8px;
+      box-shadow: 0 2px 5px rgba(0,0,0,0.1);
+    }
+    h2 {
+      color: #c53030;
+    }
+    p {
+      font-size: 16px;
+      color: #555555;
+    }
+    .detail-table {
+      width: 100%%;
+      border-collapse: collapse;
+      margin: 20px 0;
+      font-size: 15px;
+      color: #2d3748;
+    }
+    .detail-table th, .detail-table td {
+      border: 1px solid #e2e8f0;
+      padding: 8px;
+      text-align: left;
+    }
+    .detail-table th {
+      background-color: #f0f0f0;
+      width: 35%%;
+    }
+    .cta-button {
+      display: inline-block;
+      background-color: #2b6cb0;
+      color: #ffffff;
+      text-decoration: none;
+      padding: 12px 20px;
+      border-radius: 5px;
+      font-weight: bold;
+    }
+    .footer {
+      text-align: center;
+      font-size: 12px;
+      color: #999999;
+      margin-top: 20px;
+    }
+  </style>
+</head>
+<body>
+  <div class="email-container">
+    <h2>Permintaan melewati batas waktu SLA</h2>
+    <p>Halo, %s</p>
+    <p>Permintaan berikut belum ditindaklanjuti hingga batas waktu yang ditentukan:</p>
+    <table class="detail-table">
+      <tr><th>Perihal</th><td>%s</td></tr>
+      <tr><th>Nomor</th><td>%v</td></tr>
+      <tr><th>Status</th><td>%s</td></tr>
+      <tr><th>Penanggung jawab</th><td>%s</td></tr>
+      <tr><th>Batas waktu</th><td>%s WIB</td></tr>
+    </table>
+    <p>Mohon segera menindaklanjuti permintaan tersebut.</p>
+    <a href="%s" class="cta-button">Lihat permintaan</a>
+    <p>Salam,<br>Aplikasi Cataloging</p>
+    <div class="footer">&copy; 2025 PT Borneo Alumina Indonesia. Seluruh hak cipta dilindungi undang-undang.</div>
+  </div>
+</body>
 </html>`
 )
 
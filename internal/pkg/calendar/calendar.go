@@ -0,0 +1,55 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+)
+
+var WIB = time.FixedZone("WIB", 7*60*60)
+
+type Calendar struct {
+	holidays map[string]struct{}
+}
+
+func New(holidays []string) (*Calendar, error) {
+	c := &Calendar{holidays: make(map[string]struct{}, len(holidays))}
+
+	for i := range holidays {
+		d, err := time.ParseInLocation(time.DateOnly, holidays[i], WIB)
+		if err != nil {
+			return nil, fmt.Errorf("invalid holiday date: %s, cause: %w", holidays[i], err)
+		}
+		c.holidays[d.Format(time.DateOnly)] = struct{}{}
+	}
+
+	return c, nil
+}
+
+func (c *Calendar) IsWorkingDay(t time.Time) bool {
+	t = t.In(WIB)
+
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		return false
+	}
+
+	_, isHoliday := c.holidays[t.Format(time.DateOnly)]
+
+	return !isHoliday
+}
+
+func (c *Calendar) AddWorkingDays(t time.Time, days int) time.Time {
+	t = t.In(WIB)
+
+	for !c.IsWorkingDay(t) {
+		t = time.Date(t.Year(), t.Month(), t.Day()+1, 8, 0, 0, 0, WIB)
+	}
+
+	for days > 0 {
+		t = t.AddDate(0, 0, 1)
+		if c.IsWorkingDay(t) {
+			days--
+		}
+	}
+
+	return t
+}
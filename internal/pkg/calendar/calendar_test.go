@@ -0,0 +1,74 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAddWorkingDays(t *testing.T) {
+	c, err := New([]string{"2025-08-18", "2025-12-25", "2025-12-26"})
+	if err != nil {
+		t.Fatalf("New() returns error: %v", err)
+	}
+
+	type args struct {
+		t    time.Time
+		days int
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want time.Time
+	}{
+		{
+			name: "within the same week",
+			args: args{t: time.Date(2025, 8, 5, 10, 0, 0, 0, WIB), days: 2},
+			want: time.Date(2025, 8, 7, 10, 0, 0, 0, WIB),
+		},
+		{
+			name: "across the weekend",
+			args: args{t: time.Date(2025, 8, 8, 10, 0, 0, 0, WIB), days: 1},
+			want: time.Date(2025, 8, 11, 10, 0, 0, 0, WIB),
+		},
+		{
+			name: "across the weekend and a holiday",
+			args: args{t: time.Date(2025, 8, 15, 10, 0, 0, 0, WIB), days: 1},
+			want: time.Date(2025, 8, 19, 10, 0, 0, 0, WIB),
+		},
+		{
+			name: "starting on a weekend",
+			args: args{t: time.Date(2025, 8, 9, 21, 0, 0, 0, WIB), days: 1},
+			want: time.Date(2025, 8, 12, 8, 0, 0, 0, WIB),
+		},
+		{
+			name: "starting on a holiday",
+			args: args{t: time.Date(2025, 12, 25, 9, 0, 0, 0, WIB), days: 2},
+			want: time.Date(2025, 12, 31, 8, 0, 0, 0, WIB),
+		},
+		{
+			name: "converts to WIB before counting",
+			args: args{t: time.Date(2025, 8, 8, 18, 0, 0, 0, time.UTC), days: 1},
+			want: time.Date(2025, 8, 12, 8, 0, 0, 0, WIB),
+		},
+		{
+			name: "zero day",
+			args: args{t: time.Date(2025, 8, 5, 10, 0, 0, 0, WIB), days: 0},
+			want: time.Date(2025, 8, 5, 10, 0, 0, 0, WIB),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.AddWorkingDays(tt.args.t, tt.args.days); !got.Equal(tt.want) {
+				t.Errorf("AddWorkingDays() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New([]string{"25-12-2025"}); err == nil {
+		t.Errorf("New() expects error on malformed date")
+	}
+}
@@ -0,0 +1,3 @@
+package query
+
+const MaterialRecord = `JSON_OBJECT('id', materials.id, 'number', materials.number, 'plant', (SELECT JSON_OBJECT('code', p.code, 'description', p.description, 'createdAt', p.created_at, 'updatedAt', p.updated_at) FROM plants p WHERE p.code = materials.plant_code AND p.deleted_at = 0), 'type', (SELECT JSON_OBJECT('code', t.code, 'description', t.description, 'valuationClass', t.val_class, 'createdAt', t.created_at, 'updatedAt', t.updated_at) FROM material_types t WHERE t.code = materials.type_code AND t.deleted_at = 0), 'uom', (SELECT JSON_OBJECT('code', u.code, 'description', u.description, 'createdAt', u.created_at, 'updatedAt', u.updated_at) FROM material_uoms u WHERE u.code = materials.uom_code AND u.deleted_at = 0), 'group', (SELECT JSON_OBJECT('code', g.code, 'description', g.description, 'createdAt', g.created_at, 'updatedAt', g.updated_at) FROM material_groups g WHERE g.code = materials.group_code AND g.deleted_at = 0), 'equipmentCode', materials.equipment_code, 'manufacturer', (SELECT JSON_OBJECT('code', mf.code, 'description', mf.description, 'createdAt', mf.created_at, 'updatedAt', mf.updated_at) FROM manufacturers mf WHERE mf.code = materials.manufacturer_code AND mf.deleted_at = 0), 'partNumber', materials.part_number, 'shortText', materials.short_text, 'longText', materials.long_text, 'note', materials.note, 'status', materials.status, 'requestID', materials.request_id, 'duplicateOf', materials.duplicate_of, 'revisionOf', materials.revision_of, 'createdAt', materials.created_at, 'updatedAt', materials.updated_at, 'attachments', COALESCE((SELECT JSON_ARRAYAGG(JSON_OBJECT('id', a.id, 'name', a.name, 'size', a.size, 'downloadURL', a.download_url, 'webURL', a.web_url, 'createdBy', a.created_by, 'materialID', a.material_id, 'createdAt', a.created_at, 'updatedAt', a.updated_at)) FROM assets a WHERE a.material_id = materials.id AND a.deleted_at = 0), JSON_ARRAY()), 'review', IF(materials.review_decision IS NULL, NULL, JSON_OBJECT('decision', materials.review_decision, 'reasonCode', materials.review_reason_code, 'note', materials.review_note, 'reviewedBy', materials.reviewed_by, 'reviewedAt', materials.reviewed_at)))`
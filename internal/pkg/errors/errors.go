@@ -14,78 +14,111 @@ func (e ErrorCode) String() string {
 }
 
 const (
-	JSONDecodeFailure            ErrorCode = "400001"
-	JSONEncodeFailure            ErrorCode = "400002"
-	JSONValidationFailure        ErrorCode = "400003"
-	InvalidQueryParameter        ErrorCode = "400004"
-	InvalidItemNumberPerPage     ErrorCode = "400005"
-	InvalidPageNumber            ErrorCode = "400006"
-	UnknownField                 ErrorCode = "400007"
-	ParsingFileFailure           ErrorCode = "400008"
-	FileOversize                 ErrorCode = "400009"
-	EmptySpreadsheet             ErrorCode = "400010"
-	InvalidTask                  ErrorCode = "400011"
-	UserPasswordMismatch         ErrorCode = "401001"
-	MissingAuthorizationHeader   ErrorCode = "401002"
-	InvalidAuthorizationType     ErrorCode = "401003"
-	InvalidJWTSigningMethod      ErrorCode = "401004"
-	ParseTokenFailure            ErrorCode = "401005"
-	InvalidToken                 ErrorCode = "401006"
-	ExpiredToken                 ErrorCode = "401007"
-	InvalidMSGraphAuthCode       ErrorCode = "401008"
-	InvalidMSGraphToken          ErrorCode = "401009"
-	ResourceIsForbidden          ErrorCode = "403001"
-	IllegalUseOfRefreshToken     ErrorCode = "403002"
-	IllegalUserOfAccessToken     ErrorCode = "403003"
-	ExpiredOTP                   ErrorCode = "403004"
-	UserIsUnverified             ErrorCode = "404005"
-	UserNotFound                 ErrorCode = "404001"
-	UserOTPNotFound              ErrorCode = "404002"
-	MaterialTypeNotFound         ErrorCode = "404003"
-	MaterialUoMNotFound          ErrorCode = "404004"
-	MaterialGroupNotFound        ErrorCode = "404005"
-	MaterialPropertiesNotFound   ErrorCode = "404006"
-	AssetNotFound                ErrorCode = "404007"
-	RequestNotFound              ErrorCode = "404008"
-	PlantNotFound                ErrorCode = "404009"
-	ManufacturerNotFound         ErrorCode = "404010"
-	UserAlreadyExists            ErrorCode = "409001"
-	UserOTPAlreadyExists         ErrorCode = "409002"
-	UserAlreadyVerified          ErrorCode = "409003"
-	MaterialTypeAlreadyExists    ErrorCode = "409004"
-	MaterialUoMAlreadyExists     ErrorCode = "409005"
-	MaterialGroupAlreadyExists   ErrorCode = "409006"
-	AssetAlreadyExists           ErrorCode = "409007"
-	PlantAlreadyExists           ErrorCode = "409008"
-	ManufacturerAlreadyExists    ErrorCode = "409009"
-	DuplicateSpreadsheetColumn   ErrorCode = "409010"
-	UnsupportedFileType          ErrorCode = "415001"
-	UnknownGrantType             ErrorCode = "422001"
-	MissingMSGraphParameter      ErrorCode = "422002"
-	MissingMSGraphAuthCode       ErrorCode = "422003"
-	MalformedRequestID           ErrorCode = "422004"
-	TooManyRequest               ErrorCode = "429001"
-	GeneratePasswordFailure      ErrorCode = "500001"
-	RunQueryFailure              ErrorCode = "500002"
-	RowsAffectedFailure          ErrorCode = "500003"
-	ScanRowsFailure              ErrorCode = "500004"
-	PrepareStatementFailure      ErrorCode = "500005"
-	BuildQueryFailure            ErrorCode = "500006"
-	StartingTransactionFailure   ErrorCode = "500007"
-	CommittingTransactionFailure ErrorCode = "500008"
-	GenerateJWTFailure           ErrorCode = "500009"
-	CreateHTTPRequestFailure     ErrorCode = "500010"
-	SendHTTPRequestFailure       ErrorCode = "500011"
-	UndefinedJWTSecret           ErrorCode = "500012"
-	GenerateOTPFailure           ErrorCode = "500013"
-	CreateFormFileFailure        ErrorCode = "500014"
-	CopyFileFailure              ErrorCode = "500015"
-	PanicGeneralFailure          ErrorCode = "500016"
-	EnqueueTaskFailure           ErrorCode = "500017"
-	GetMSGraphTokenFailure       ErrorCode = "502001"
-	SendEmailFailure             ErrorCode = "502002"
-	UploadFileFailure            ErrorCode = "502003"
-	DeleteFileFailure            ErrorCode = "502004"
+	JSONDecodeFailure              ErrorCode = "400001"
+	JSONEncodeFailure              ErrorCode = "400002"
+	JSONValidationFailure          ErrorCode = "400003"
+	InvalidQueryParameter          ErrorCode = "400004"
+	InvalidItemNumberPerPage       ErrorCode = "400005"
+	InvalidPageNumber              ErrorCode = "400006"
+	UnknownField                   ErrorCode = "400007"
+	ParsingFileFailure             ErrorCode = "400008"
+	FileOversize                   ErrorCode = "400009"
+	EmptySpreadsheet               ErrorCode = "400010"
+	InvalidTask                    ErrorCode = "400011"
+	InvalidSpreadsheetRow          ErrorCode = "400012"
+	TooManyRows                    ErrorCode = "400013"
+	UserPasswordMismatch           ErrorCode = "401001"
+	MissingAuthorizationHeader     ErrorCode = "401002"
+	InvalidAuthorizationType       ErrorCode = "401003"
+	InvalidJWTSigningMethod        ErrorCode = "401004"
+	ParseTokenFailure              ErrorCode = "401005"
+	InvalidToken                   ErrorCode = "401006"
+	ExpiredToken                   ErrorCode = "401007"
+	InvalidMSGraphAuthCode         ErrorCode = "401008"
+	InvalidMSGraphToken            ErrorCode = "401009"
+	ResourceIsForbidden            ErrorCode = "403001"
+	IllegalUseOfRefreshToken       ErrorCode = "403002"
+	IllegalUserOfAccessToken       ErrorCode = "403003"
+	ExpiredOTP                     ErrorCode = "403004"
+	RequestTransitionIsForbidden   ErrorCode = "403005"
+	RequestIsAssignedToOthers      ErrorCode = "403006"
+	UserIsUnverified               ErrorCode = "404005"
+	UserNotFound                   ErrorCode = "404001"
+	UserOTPNotFound                ErrorCode = "404002"
+	MaterialTypeNotFound           ErrorCode = "404003"
+	MaterialUoMNotFound            ErrorCode = "404004"
+	MaterialGroupNotFound          ErrorCode = "404005"
+	MaterialPropertiesNotFound     ErrorCode = "404006"
+	AssetNotFound                  ErrorCode = "404007"
+	RequestNotFound                ErrorCode = "404008"
+	PlantNotFound                  ErrorCode = "404009"
+	ManufacturerNotFound           ErrorCode = "404010"
+	MaterialNotFound               ErrorCode = "404011"
+	CommentNotFound                ErrorCode = "404012"
+	UnassignedRequestNotFound      ErrorCode = "404013"
+	MaterialNumberNotFound         ErrorCode = "404014"
+	AbbreviationNotFound           ErrorCode = "404015"
+	ImportJobNotFound              ErrorCode = "404016"
+	UserAlreadyExists              ErrorCode = "409001"
+	UserOTPAlreadyExists           ErrorCode = "409002"
+	UserAlreadyVerified            ErrorCode = "409003"
+	MaterialTypeAlreadyExists      ErrorCode = "409004"
+	MaterialUoMAlreadyExists       ErrorCode = "409005"
+	MaterialGroupAlreadyExists     ErrorCode = "409006"
+	AssetAlreadyExists             ErrorCode = "409007"
+	PlantAlreadyExists             ErrorCode = "409008"
+	ManufacturerAlreadyExists      ErrorCode = "409009"
+	DuplicateSpreadsheetColumn     ErrorCode = "409010"
+	InvalidRequestStatusTransition ErrorCode = "409011"
+	RequestIsNotEditable           ErrorCode = "409012"
+	RequestIsNotReviewable         ErrorCode = "409013"
+	AbbreviationAlreadyExists      ErrorCode = "409014"
+	RequestIsNotApproved           ErrorCode = "409015"
+	ImportJobIsNotPreviewed        ErrorCode = "409016"
+	PlantMaterialTypeAlreadyExists ErrorCode = "409017"
+	MaterialTypeGroupAlreadyExists ErrorCode = "409018"
+	UnsupportedFileType            ErrorCode = "415001"
+	UnknownGrantType               ErrorCode = "422001"
+	MissingMSGraphParameter        ErrorCode = "422002"
+	MissingMSGraphAuthCode         ErrorCode = "422003"
+	MalformedRequestID             ErrorCode = "422004"
+	MalformedCommentID             ErrorCode = "422005"
+	MalformedMaterialID            ErrorCode = "422006"
+	InvalidAssignee                ErrorCode = "422007"
+	InvalidShortText               ErrorCode = "422008"
+	MissingMaterialAttachment      ErrorCode = "422009"
+	MalformedImportJobID           ErrorCode = "422010"
+	MaterialTypeIsNotAllowed       ErrorCode = "422011"
+	MaterialGroupIsNotAllowed      ErrorCode = "422012"
+	TooManyRequest                 ErrorCode = "429001"
+	GeneratePasswordFailure        ErrorCode = "500001"
+	RunQueryFailure                ErrorCode = "500002"
+	RowsAffectedFailure            ErrorCode = "500003"
+	ScanRowsFailure                ErrorCode = "500004"
+	PrepareStatementFailure        ErrorCode = "500005"
+	BuildQueryFailure              ErrorCode = "500006"
+	StartingTransactionFailure     ErrorCode = "500007"
+	CommittingTransactionFailure   ErrorCode = "500008"
+	GenerateJWTFailure             ErrorCode = "500009"
+	CreateHTTPRequestFailure       ErrorCode = "500010"
+	SendHTTPRequestFailure         ErrorCode = "500011"
+	UndefinedJWTSecret             ErrorCode = "500012"
+	GenerateOTPFailure             ErrorCode = "500013"
+	CreateFormFileFailure          ErrorCode = "500014"
+	CopyFileFailure                ErrorCode = "500015"
+	PanicGeneralFailure            ErrorCode = "500016"
+	EnqueueTaskFailure             ErrorCode = "500017"
+	BuildIDocFailure               ErrorCode = "500018"
+	MaterialNumberRangeExhausted   ErrorCode = "500019"
+	WriteFileFailure               ErrorCode = "500020"
+	IDocExportIsNotConfigured      ErrorCode = "501001"
+	PublisherIsNotConfigured       ErrorCode = "501002"
+	GetMSGraphTokenFailure         ErrorCode = "502001"
+	SendEmailFailure               ErrorCode = "502002"
+	UploadFileFailure              ErrorCode = "502003"
+	DeleteFileFailure              ErrorCode = "502004"
+	FetchSAPCSRFTokenFailure       ErrorCode = "502005"
+	PublishMaterialFailure         ErrorCode = "502006"
 )
 
 type Error struct {
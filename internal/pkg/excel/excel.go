@@ -2,133 +2,368 @@ package excel
 
 import (
 	"archive/zip"
-	"bytes"
 	"encoding/xml"
 	"fmt"
 	"io"
+	"math"
+	"path"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/dev-pt-bai/cataloging/configs"
 	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
 )
 
-type Parser struct{}
+const (
+	defaultMaxFileSize = int64(20) << 20
+	defaultMaxRows     = 100000
+)
 
-func NewParser() *Parser { return &Parser{} }
+type Parser struct {
+	maxFileSize int64
+	maxRows     int
+}
 
-func (p *Parser) Open(r io.Reader) ([][]string, *errors.Error) {
-	data, err := io.ReadAll(r)
-	if err != nil {
-		return nil, errors.New(errors.ParsingFileFailure).Wrap(err)
+func NewParser(config *configs.Config) (*Parser, error) {
+	if config == nil {
+		return nil, fmt.Errorf("missing config")
+	}
+
+	p := &Parser{maxFileSize: defaultMaxFileSize, maxRows: defaultMaxRows}
+	if config.App.Spreadsheet.MaxFileSizeMB > 0 {
+		p.maxFileSize = config.App.Spreadsheet.MaxFileSizeMB << 20
+	}
+	if config.App.Spreadsheet.MaxRows > 0 {
+		p.maxRows = config.App.Spreadsheet.MaxRows
 	}
 
-	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	return p, nil
+}
+
+func (p *Parser) MaxFileSize() int64 {
+	return p.maxFileSize
+}
+
+func (p *Parser) MaxRows() int {
+	return p.maxRows
+}
+
+func (p *Parser) Open(r io.Reader) ([][]string, *errors.Error) {
+	return p.OpenSheet(r, "")
+}
+
+func (p *Parser) OpenSheet(r io.Reader, name string) ([][]string, *errors.Error) {
+	rr, err := p.NewRowReader(r, name)
 	if err != nil {
-		return nil, errors.New(errors.ParsingFileFailure).Wrap(err)
+		return nil, err
 	}
+	defer rr.Close()
 
-	var sharedStringsFile, sheetFile *zip.File
-	for _, f := range zr.File {
-		if sharedStringsFile != nil && sheetFile != nil {
-			break
-		}
-		switch f.Name {
-		case "xl/sharedStrings.xml":
-			sharedStringsFile = f
-		case "xl/worksheets/sheet1.xml":
-			sheetFile = f
+	rows := make([][]string, 0)
+	for rr.Next() {
+		for len(rows) < rr.RowNumber()-1 {
+			rows = append(rows, []string{})
 		}
+		rows = append(rows, rr.Row())
 	}
 
-	sharedStrings, err := parseSharedStrings(sharedStringsFile)
-	if err != nil {
-		return nil, errors.New(errors.ParsingFileFailure).Wrap(err)
+	if err := rr.Err(); err != nil {
+		return nil, err
 	}
 
-	sheet, err := parseSheet(sheetFile, sharedStrings)
-	if err != nil {
-		return nil, errors.New(errors.ParsingFileFailure).Wrap(err)
+	return rows, nil
+}
+
+type workbook struct {
+	sheets   []workbookSheet
+	targets  map[string]string
+	date1904 bool
+}
+
+type workbookSheet struct {
+	Name string `xml:"name,attr"`
+	RID  string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+}
+
+type workbookXMLFile struct {
+	Properties struct {
+		Date1904 string `xml:"date1904,attr"`
+	} `xml:"workbookPr"`
+	Sheets []workbookSheet `xml:"sheets>sheet"`
+}
+
+type relationships struct {
+	Items []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+func parseWorkbook(files map[string]*zip.File) (*workbook, error) {
+	wb := &workbook{targets: make(map[string]string)}
+
+	wf := new(workbookXMLFile)
+	if err := decodeXML(files["xl/workbook.xml"], wf); err != nil {
+		return nil, fmt.Errorf("failed to decode xml of workbook: %w", err)
+	}
+	wb.sheets = wf.Sheets
+	wb.date1904 = wf.Properties.Date1904 == "1" || wf.Properties.Date1904 == "true"
+
+	rels := new(relationships)
+	if err := decodeXML(files["xl/_rels/workbook.xml.rels"], rels); err != nil {
+		return nil, fmt.Errorf("failed to decode xml of workbook relationships: %w", err)
+	}
+	for _, rel := range rels.Items {
+		target := rel.Target
+		if strings.HasPrefix(target, "/") {
+			target = strings.TrimPrefix(target, "/")
+		} else {
+			target = path.Join("xl", target)
+		}
+		wb.targets[rel.ID] = target
 	}
 
-	return sheet, nil
+	return wb, nil
 }
 
-type sharedStrings struct {
-	XMLName xml.Name     `xml:"sst"`
-	Items   []sharedItem `xml:"si"`
+func (wb *workbook) sheetPath(name string) (string, error) {
+	if len(wb.sheets) == 0 {
+		if len(name) == 0 {
+			return "xl/worksheets/sheet1.xml", nil
+		}
+		return "", fmt.Errorf("sheet %s is not found", name)
+	}
+
+	for _, s := range wb.sheets {
+		if len(name) > 0 && !strings.EqualFold(s.Name, name) {
+			continue
+		}
+		target, exists := wb.targets[s.RID]
+		if !exists {
+			return "", fmt.Errorf("missing relationship %s of sheet %s", s.RID, s.Name)
+		}
+		return target, nil
+	}
+
+	return "", fmt.Errorf("sheet %s is not found", name)
 }
 
-type sharedItem struct {
+type textRun struct {
 	T string `xml:"t"`
 }
 
-func parseSharedStrings(zf *zip.File) ([]string, error) {
-	if zf == nil {
-		return nil, nil
-	}
+type richText struct {
+	T    *string   `xml:"t"`
+	Runs []textRun `xml:"r"`
+}
 
-	rc, err := zf.Open()
-	if err != nil {
-		return nil, fmt.Errorf("failed to open zip file of shared strings: %w", err)
+func (rt richText) String() string {
+	var b strings.Builder
+	if rt.T != nil {
+		b.WriteString(*rt.T)
+	}
+	for _, r := range rt.Runs {
+		b.WriteString(r.T)
 	}
-	defer rc.Close()
 
+	return b.String()
+}
+
+type sharedStrings struct {
+	XMLName xml.Name   `xml:"sst"`
+	Items   []richText `xml:"si"`
+}
+
+func parseSharedStrings(zf *zip.File) ([]string, error) {
 	ss := new(sharedStrings)
-	if err := xml.NewDecoder(rc).Decode(ss); err != nil {
+	if err := decodeXML(zf, ss); err != nil {
 		return nil, fmt.Errorf("failed to decode xml of shared strings: %w", err)
 	}
 
 	values := make([]string, len(ss.Items))
 	for i, item := range ss.Items {
-		values[i] = item.T
+		values[i] = item.String()
 	}
 
 	return values, nil
 }
 
+type styleSheet struct {
+	NumFmts []struct {
+		ID   int    `xml:"numFmtId,attr"`
+		Code string `xml:"formatCode,attr"`
+	} `xml:"numFmts>numFmt"`
+	CellXfs []struct {
+		NumFmtID int `xml:"numFmtId,attr"`
+	} `xml:"cellXfs>xf"`
+}
+
+func parseStyles(zf *zip.File) (map[int]bool, error) {
+	ss := new(styleSheet)
+	if err := decodeXML(zf, ss); err != nil {
+		return nil, fmt.Errorf("failed to decode xml of styles: %w", err)
+	}
+
+	customDates := make(map[int]bool, len(ss.NumFmts))
+	for _, f := range ss.NumFmts {
+		customDates[f.ID] = isDateFormat(f.Code)
+	}
+
+	dateStyles := make(map[int]bool, len(ss.CellXfs))
+	for i, xf := range ss.CellXfs {
+		id := xf.NumFmtID
+		if (id >= 14 && id <= 22) || (id >= 45 && id <= 47) || customDates[id] {
+			dateStyles[i] = true
+		}
+	}
+
+	return dateStyles, nil
+}
+
+func isDateFormat(code string) bool {
+	var b strings.Builder
+	inQuote, inBracket, escaped := false, false, false
+	for _, r := range strings.ToLower(code) {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"':
+			inQuote = !inQuote
+		case inQuote:
+		case r == '[':
+			inBracket = true
+		case r == ']':
+			inBracket = false
+		case inBracket:
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return strings.ContainsAny(b.String(), "ydhs")
+}
+
 type cell struct {
-	R string `xml:"r,attr"`
-	T string `xml:"t,attr"`
-	V string `xml:"v"`
+	R  string    `xml:"r,attr"`
+	T  string    `xml:"t,attr"`
+	S  int       `xml:"s,attr"`
+	V  string    `xml:"v"`
+	IS *richText `xml:"is"`
 }
 
 type row struct {
+	R     int    `xml:"r,attr"`
 	Cells []cell `xml:"c"`
 }
 
-type sheet struct {
-	Rows []row `xml:"sheetData>row"`
+type cellFormatter struct {
+	shared     []string
+	dateStyles map[int]bool
+	date1904   bool
 }
 
-func parseSheet(zf *zip.File, shared []string) ([][]string, error) {
-	if zf == nil {
-		return nil, nil
+func (f cellFormatter) format(c cell) string {
+	switch c.T {
+	case "s":
+		idx, err := strconv.Atoi(c.V)
+		if err == nil && idx >= 0 && idx < len(f.shared) {
+			return f.shared[idx]
+		}
+		return c.V
+	case "inlineStr":
+		if c.IS != nil {
+			return c.IS.String()
+		}
+		return c.V
+	case "b":
+		if c.V == "1" {
+			return "TRUE"
+		}
+		return "FALSE"
+	case "", "n":
+		if f.dateStyles[c.S] {
+			if serial, err := strconv.ParseFloat(c.V, 64); err == nil {
+				return f.date(serial)
+			}
+		}
+		return c.V
+	default:
+		return c.V
 	}
+}
 
-	rc, err := zf.Open()
-	if err != nil {
-		return nil, fmt.Errorf("failed to open zip file of sheet: %w", err)
+func (f cellFormatter) date(serial float64) string {
+	epoch := time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+	if f.date1904 {
+		epoch = time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)
 	}
-	defer rc.Close()
 
-	s := new(sheet)
-	if err := xml.NewDecoder(rc).Decode(s); err != nil {
-		return nil, fmt.Errorf("failed to decode xml of sheet: %w", err)
-	}
-
-	rows := make([][]string, len(s.Rows))
-	for i, row := range s.Rows {
-		rows[i] = make([]string, len(row.Cells))
-		for j, cell := range row.Cells {
-			value := cell.V
-			if cell.T == "s" {
-				idx, err := strconv.Atoi(cell.V)
-				if err == nil && idx < len(shared) {
-					value = shared[idx]
-				}
+	days := math.Floor(serial)
+	seconds := math.Round((serial - days) * 86400)
+	t := epoch.AddDate(0, 0, int(days)).Add(time.Duration(seconds) * time.Second)
+
+	if seconds == 0 {
+		return t.Format(time.DateOnly)
+	}
+
+	return t.Format(time.DateTime)
+}
+
+func (f cellFormatter) values(cells []cell) ([]string, error) {
+	values := make([]string, 0, len(cells))
+	for _, c := range cells {
+		col := len(values)
+		if len(c.R) > 0 {
+			idx, err := columnIndex(c.R)
+			if err != nil {
+				return nil, err
 			}
-			rows[i][j] = value
+			col = idx
+		}
+		for len(values) < col {
+			values = append(values, "")
+		}
+		if col < len(values) {
+			values[col] = f.format(c)
+			continue
 		}
+		values = append(values, f.format(c))
 	}
 
-	return rows, nil
+	return values, nil
+}
+
+func columnIndex(ref string) (int, error) {
+	idx := 0
+	n := 0
+	for _, r := range strings.ToUpper(ref) {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		idx = idx*26 + int(r-'A'+1)
+		n++
+	}
+
+	if n == 0 || n > 3 {
+		return 0, fmt.Errorf("invalid cell reference: %s", ref)
+	}
+
+	return idx - 1, nil
+}
+
+func decodeXML(zf *zip.File, v any) error {
+	if zf == nil {
+		return nil
+	}
+
+	rc, err := zf.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open zip file %s: %w", zf.Name, err)
+	}
+	defer rc.Close()
+
+	return xml.NewDecoder(rc).Decode(v)
 }
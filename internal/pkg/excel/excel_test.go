@@ -0,0 +1,152 @@
+package excel
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dev-pt-bai/cataloging/configs"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+)
+
+func newParser(t *testing.T, config *configs.Config) *Parser {
+	t.Helper()
+
+	p, err := NewParser(config)
+	if err != nil {
+		t.Fatalf("NewParser() error = %v", err)
+	}
+
+	return p
+}
+
+func newWorkbook(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	for name, content := range files {
+		f, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("failed to create %s: %v", name, err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("failed to close workbook: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestParserOpenSheet(t *testing.T) {
+	data := newWorkbook(t, map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="Notes" sheetId="1" r:id="rId2"/><sheet name="Manufacturers" sheetId="2" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Target="worksheets/sheet1.xml"/><Relationship Id="rId2" Target="/xl/worksheets/sheet2.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<si><t>Code</t></si><si><t>Description</t></si><si><r><t>Shell </t></r><r><rPr><b/></rPr><t>Global</t></r><rPh><t>x</t></rPh></si></sst>`,
+		"xl/styles.xml": `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<numFmts><numFmt numFmtId="164" formatCode="dd/mm/yyyy"/><numFmt numFmtId="165" formatCode="&quot;day&quot; 0"/></numFmts>` +
+			`<cellXfs><xf numFmtId="0"/><xf numFmtId="14"/><xf numFmtId="164"/><xf numFmtId="165"/></cellXfs></styleSheet>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+			`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="C1" t="s"><v>1</v></c></row>` +
+			`<row r="2"><c r="C2" t="s"><v>2</v></c></row>` +
+			`<row r="4"><c r="A4" t="inlineStr"><is><t>SHL</t></is></c><c r="B4" t="b"><v>1</v></c><c r="C4" t="inlineStr"><is><r><t>Rich </t></r><r><t>inline</t></r></is></c></row>` +
+			`<row r="5"><c r="A5" s="1"><v>45292</v></c><c r="B5" s="2"><v>45292.5</v></c><c r="C5" s="3"><v>7</v></c><c r="D5" t="e"><v>#N/A</v></c><c r="E5" t="str"><v>SHL-01</v></c></row>` +
+			`</sheetData></worksheet>`,
+		"xl/worksheets/sheet2.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+			`<row r="1"><c r="B1" t="inlineStr"><is><t>Notes</t></is></c></row></sheetData></worksheet>`,
+	})
+
+	got, err := newParser(t, new(configs.Config)).OpenSheet(bytes.NewReader(data), "manufacturers")
+	if err != nil {
+		t.Fatalf("OpenSheet() error = %v", err)
+	}
+
+	want := [][]string{
+		{"Code", "", "Description"},
+		{"", "", "Shell Global"},
+		{},
+		{"SHL", "TRUE", "Rich inline"},
+		{"2024-01-01", "2024-01-01 12:00:00", "7", "#N/A", "SHL-01"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("OpenSheet() = %q, want %q", got, want)
+	}
+
+	got, err = newParser(t, new(configs.Config)).Open(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	if want := [][]string{{"", "Notes"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Open() = %q, want %q", got, want)
+	}
+
+	if _, err := newParser(t, new(configs.Config)).OpenSheet(bytes.NewReader(data), "Plants"); err == nil {
+		t.Errorf("OpenSheet() with unknown sheet returns nil error")
+	}
+}
+
+func TestRowReader(t *testing.T) {
+	sheet := new(strings.Builder)
+	sheet.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	sheet.WriteString(`<row r="1"><c r="A1" t="inlineStr"><is><t>Code</t></is></c></row>`)
+	for i := 3; i <= 6; i++ {
+		fmt.Fprintf(sheet, `<row r="%d"><c r="B%d"><v>%d</v></c></row>`, i, i, i)
+	}
+	sheet.WriteString(`</sheetData></worksheet>`)
+	data := newWorkbook(t, map[string]string{"xl/worksheets/sheet1.xml": sheet.String()})
+
+	config := new(configs.Config)
+	config.App.Spreadsheet.MaxRows = 5
+	rr, err := newParser(t, config).NewRowReader(bytes.NewReader(data), "")
+	if err != nil {
+		t.Fatalf("NewRowReader() error = %v", err)
+	}
+
+	numbers := make([]int, 0, 3)
+	for rr.Next() {
+		numbers = append(numbers, rr.RowNumber())
+	}
+	if want := []int{1, 3, 4, 5}; !reflect.DeepEqual(numbers, want) {
+		t.Errorf("RowNumber() = %v, want %v", numbers, want)
+	}
+	if rr.Next() || !rr.Err().ContainsCodes(errors.TooManyRows) {
+		t.Errorf("Err() = %v, want %s", rr.Err(), errors.TooManyRows)
+	}
+
+	name := rr.file.Name()
+	if err := rr.Close(); err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+	if _, err := os.Stat(name); !os.IsNotExist(err) {
+		t.Errorf("temporary file %s is not removed", name)
+	}
+
+	config.App.Spreadsheet.MaxFileSizeMB = 1
+	if _, err := newParser(t, config).NewRowReader(bytes.NewReader(make([]byte, 2<<20)), ""); !err.ContainsCodes(errors.FileOversize) {
+		t.Errorf("NewRowReader() error = %v, want %s", err, errors.FileOversize)
+	}
+}
+
+func TestColumnIndex(t *testing.T) {
+	for ref, want := range map[string]int{"A1": 0, "Z10": 25, "AA3": 26, "az7": 51, "BA1": 52, "ZZ2": 701, "AAA9": 702} {
+		if got, err := columnIndex(ref); err != nil || got != want {
+			t.Errorf("columnIndex(%s) = %d, %v, want %d", ref, got, err, want)
+		}
+	}
+
+	for _, ref := range []string{"", "12", "ABCD1"} {
+		if _, err := columnIndex(ref); err == nil {
+			t.Errorf("columnIndex(%s) returns nil error", ref)
+		}
+	}
+}
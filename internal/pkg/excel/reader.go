@@ -0,0 +1,165 @@
+package excel
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+)
+
+type RowReader struct {
+	file      *os.File
+	sheet     io.ReadCloser
+	decoder   *xml.Decoder
+	formatter cellFormatter
+	maxRows   int
+	row       []string
+	number    int
+	err       *errors.Error
+}
+
+func (p *Parser) NewRowReader(r io.Reader, sheetName string) (*RowReader, *errors.Error) {
+	f, err := os.CreateTemp("", "cataloging-*.xlsx")
+	if err != nil {
+		return nil, errors.New(errors.ParsingFileFailure).Wrap(err)
+	}
+
+	rr := &RowReader{file: f, maxRows: p.maxRows}
+	if errOpen := rr.open(r, p.maxFileSize, sheetName); errOpen != nil {
+		rr.Close()
+		return nil, errOpen
+	}
+
+	return rr, nil
+}
+
+func (rr *RowReader) open(r io.Reader, maxFileSize int64, sheetName string) *errors.Error {
+	size, err := io.Copy(rr.file, io.LimitReader(r, maxFileSize+1))
+	if err != nil {
+		return errors.New(errors.ParsingFileFailure).Wrap(err)
+	}
+	if size > maxFileSize {
+		return errors.New(errors.FileOversize).Wrap(fmt.Errorf("file exceeds %d bytes", maxFileSize))
+	}
+
+	zr, err := zip.NewReader(rr.file, size)
+	if err != nil {
+		return errors.New(errors.ParsingFileFailure).Wrap(err)
+	}
+
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	wb, err := parseWorkbook(files)
+	if err != nil {
+		return errors.New(errors.ParsingFileFailure).Wrap(err)
+	}
+
+	sheetPath, err := wb.sheetPath(sheetName)
+	if err != nil {
+		return errors.New(errors.ParsingFileFailure).Wrap(err)
+	}
+
+	shared, err := parseSharedStrings(files["xl/sharedStrings.xml"])
+	if err != nil {
+		return errors.New(errors.ParsingFileFailure).Wrap(err)
+	}
+
+	styles, err := parseStyles(files["xl/styles.xml"])
+	if err != nil {
+		return errors.New(errors.ParsingFileFailure).Wrap(err)
+	}
+	rr.formatter = cellFormatter{shared: shared, dateStyles: styles, date1904: wb.date1904}
+
+	zf, exists := files[sheetPath]
+	if !exists {
+		return errors.New(errors.ParsingFileFailure).Wrap(fmt.Errorf("missing sheet part %s", sheetPath))
+	}
+
+	rr.sheet, err = zf.Open()
+	if err != nil {
+		return errors.New(errors.ParsingFileFailure).Wrap(err)
+	}
+	rr.decoder = xml.NewDecoder(rr.sheet)
+
+	return nil
+}
+
+func (rr *RowReader) Next() bool {
+	if rr.err != nil || rr.decoder == nil {
+		return false
+	}
+
+	for {
+		token, err := rr.decoder.Token()
+		if err == io.EOF {
+			return false
+		}
+		if err != nil {
+			rr.err = errors.New(errors.ParsingFileFailure).Wrap(err)
+			return false
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "row" {
+			continue
+		}
+
+		r := new(row)
+		if err = rr.decoder.DecodeElement(r, &start); err != nil {
+			rr.err = errors.New(errors.ParsingFileFailure).Wrap(err)
+			return false
+		}
+
+		number := rr.number + 1
+		if r.R > rr.number {
+			number = r.R
+		}
+		if number > rr.maxRows {
+			rr.err = errors.New(errors.TooManyRows).Wrap(fmt.Errorf("sheet exceeds %d rows", rr.maxRows))
+			return false
+		}
+
+		values, err := rr.formatter.values(r.Cells)
+		if err != nil {
+			rr.err = errors.New(errors.ParsingFileFailure).Wrap(err)
+			return false
+		}
+
+		rr.row = values
+		rr.number = number
+
+		return true
+	}
+}
+
+func (rr *RowReader) Row() []string {
+	return rr.row
+}
+
+func (rr *RowReader) RowNumber() int {
+	return rr.number
+}
+
+func (rr *RowReader) Err() *errors.Error {
+	return rr.err
+}
+
+func (rr *RowReader) Close() error {
+	if rr.sheet != nil {
+		rr.sheet.Close()
+	}
+
+	name := rr.file.Name()
+	if err := rr.file.Close(); err != nil {
+		os.Remove(name)
+		return err
+	}
+
+	return os.Remove(name)
+}
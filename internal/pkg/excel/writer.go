@@ -0,0 +1,305 @@
+package excel
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+)
+
+const (
+	minColumnWidth = 10
+	maxColumnWidth = 60
+	maxSheetName   = 31
+	maxSheetRows   = 1048576
+
+	headerStyle      = 1
+	highlightedStyle = 2
+)
+
+type Writer struct{}
+
+func NewWriter() *Writer { return &Writer{} }
+
+type Sheet struct {
+	Name        string
+	Header      []string
+	Rows        [][]string
+	Highlighted []int
+	Hidden      bool
+	Lists       []List
+}
+
+type List struct {
+	Column string
+	Source string
+}
+
+func (w *Writer) Write(sheetName string, header []string, rows [][]string) ([]byte, *errors.Error) {
+	return w.WriteSheets([]Sheet{{Name: sheetName, Header: header, Rows: rows}})
+}
+
+func (w *Writer) WriteHighlighted(sheetName string, header []string, rows [][]string, highlighted []int) ([]byte, *errors.Error) {
+	return w.WriteSheets([]Sheet{{Name: sheetName, Header: header, Rows: rows, Highlighted: highlighted}})
+}
+
+func (w *Writer) WriteSheets(sheets []Sheet) ([]byte, *errors.Error) {
+	if len(sheets) == 0 {
+		return nil, errors.New(errors.WriteFileFailure).Wrap(fmt.Errorf("missing sheet"))
+	}
+	if sheets[0].Hidden {
+		return nil, errors.New(errors.WriteFileFailure).Wrap(fmt.Errorf("first sheet must be visible"))
+	}
+
+	names := make([]string, len(sheets))
+	sources := make(map[string]int, len(sheets))
+	for i := range sheets {
+		if len(sheets[i].Header) == 0 {
+			return nil, errors.New(errors.WriteFileFailure).Wrap(fmt.Errorf("missing header of sheet %s", sheets[i].Name))
+		}
+		names[i] = sanitizeSheetName(sheets[i].Name)
+		if slices.ContainsFunc(names[:i], func(name string) bool { return strings.EqualFold(name, names[i]) }) {
+			return nil, errors.New(errors.WriteFileFailure).Wrap(fmt.Errorf("duplicate sheet: %s", names[i]))
+		}
+		sources[sheets[i].Name] = i
+	}
+
+	ss := newStringTable()
+	workbookSheets := new(strings.Builder)
+	workbookRels := new(strings.Builder)
+	contentTypes := new(strings.Builder)
+	files := make([]zipFile, 0, len(sheets)+6)
+
+	for i := range sheets {
+		validations := make([]string, 0, len(sheets[i].Lists))
+		for _, l := range sheets[i].Lists {
+			column := slices.Index(sheets[i].Header, l.Column)
+			if column < 0 {
+				return nil, errors.New(errors.WriteFileFailure).Wrap(fmt.Errorf("unknown list column: %s", l.Column))
+			}
+			source, exists := sources[l.Source]
+			if !exists {
+				return nil, errors.New(errors.WriteFileFailure).Wrap(fmt.Errorf("unknown list source: %s", l.Source))
+			}
+			if len(sheets[source].Rows) == 0 {
+				continue
+			}
+			formula := fmt.Sprintf("'%s'!$A$2:$A$%d", strings.ReplaceAll(names[source], "'", "''"), len(sheets[source].Rows)+1)
+			validations = append(validations, fmt.Sprintf(`<dataValidation type="list" allowBlank="1" showErrorMessage="1" sqref="%[1]s2:%[1]s%[2]d"><formula1>%[3]s</formula1></dataValidation>`, columnName(column), maxSheetRows, escape(formula)))
+		}
+
+		styles := make(map[int]int, len(sheets[i].Highlighted))
+		for _, j := range sheets[i].Highlighted {
+			styles[j] = highlightedStyle
+		}
+
+		files = append(files, zipFile{name: fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), content: writeSheet(ss, sheets[i], styles, i == 0, validations)})
+
+		state := ""
+		if sheets[i].Hidden {
+			state = ` state="hidden"`
+		}
+		fmt.Fprintf(workbookSheets, `<sheet name="%s" sheetId="%d"%s r:id="rId%d"/>`, escape(names[i]), i+1, state, i+1)
+		fmt.Fprintf(workbookRels, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i+1, i+1)
+		fmt.Fprintf(contentTypes, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i+1)
+	}
+
+	files = append(files, []zipFile{
+		{name: "[Content_Types].xml", content: fmt.Appendf(nil, contentTypesXML, contentTypes.String())},
+		{name: "_rels/.rels", content: []byte(rootRelsXML)},
+		{name: "xl/workbook.xml", content: fmt.Appendf(nil, workbookXML, workbookSheets.String())},
+		{name: "xl/_rels/workbook.xml.rels", content: fmt.Appendf(nil, workbookRelsXML, workbookRels.String(), len(sheets)+1, len(sheets)+2)},
+		{name: "xl/styles.xml", content: []byte(stylesXML)},
+		{name: "xl/sharedStrings.xml", content: ss.bytes()},
+	}...)
+
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	for i := range files {
+		f, err := zw.Create(files[i].name)
+		if err != nil {
+			return nil, errors.New(errors.WriteFileFailure).Wrap(err)
+		}
+		if _, err = f.Write(files[i].content); err != nil {
+			return nil, errors.New(errors.WriteFileFailure).Wrap(err)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, errors.New(errors.WriteFileFailure).Wrap(err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+type zipFile struct {
+	name    string
+	content []byte
+}
+
+func writeSheet(ss *stringTable, s Sheet, styles map[int]int, selected bool, validations []string) []byte {
+	widths := make([]int, len(s.Header))
+
+	sheet := new(bytes.Buffer)
+	sheet.WriteString(xml.Header)
+	sheet.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	tabSelected := ""
+	if selected {
+		tabSelected = ` tabSelected="1"`
+	}
+	fmt.Fprintf(sheet, `<sheetViews><sheetView%s workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`, tabSelected)
+
+	data := new(bytes.Buffer)
+	writeRow(data, ss, widths, 1, s.Header, headerStyle)
+	for i := range s.Rows {
+		writeRow(data, ss, widths, i+2, s.Rows[i][:min(len(s.Rows[i]), len(s.Header))], styles[i])
+	}
+
+	sheet.WriteString(`<cols>`)
+	for i := range widths {
+		fmt.Fprintf(sheet, `<col min="%d" max="%d" width="%d" customWidth="1"/>`, i+1, i+1, min(maxColumnWidth, max(minColumnWidth, widths[i]+2)))
+	}
+	sheet.WriteString(`</cols><sheetData>`)
+	sheet.Write(data.Bytes())
+	sheet.WriteString(`</sheetData>`)
+	if len(validations) > 0 {
+		fmt.Fprintf(sheet, `<dataValidations count="%d">%s</dataValidations>`, len(validations), strings.Join(validations, ""))
+	}
+	sheet.WriteString(`</worksheet>`)
+
+	return sheet.Bytes()
+}
+
+func writeRow(w io.Writer, ss *stringTable, widths []int, r int, values []string, style int) {
+	fmt.Fprintf(w, `<row r="%d">`, r)
+	for j := range values {
+		if n := utf8.RuneCountInString(values[j]); n > widths[j] {
+			widths[j] = n
+		}
+		if style > 0 {
+			fmt.Fprintf(w, `<c r="%s%d" s="%d" t="s"><v>%d</v></c>`, columnName(j), r, style, ss.index(values[j]))
+			continue
+		}
+		fmt.Fprintf(w, `<c r="%s%d" t="s"><v>%d</v></c>`, columnName(j), r, ss.index(values[j]))
+	}
+	io.WriteString(w, `</row>`)
+}
+
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+
+	return name
+}
+
+type stringTable struct {
+	values  []string
+	indexes map[string]int
+	count   int
+}
+
+func newStringTable() *stringTable {
+	return &stringTable{indexes: make(map[string]int)}
+}
+
+func (s *stringTable) index(value string) int {
+	s.count++
+	if i, exists := s.indexes[value]; exists {
+		return i
+	}
+
+	s.indexes[value] = len(s.values)
+	s.values = append(s.values, value)
+
+	return len(s.values) - 1
+}
+
+func (s *stringTable) bytes() []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteString(xml.Header)
+	fmt.Fprintf(buf, `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" count="%d" uniqueCount="%d">`, s.count, len(s.values))
+	for i := range s.values {
+		fmt.Fprintf(buf, `<si><t xml:space="preserve">%s</t></si>`, escape(s.values[i]))
+	}
+	buf.WriteString(`</sst>`)
+
+	return buf.Bytes()
+}
+
+func escape(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 0x20 && r != '\t' && r != '\n' && r != '\r' {
+			return -1
+		}
+		return r
+	}, s)
+
+	buf := new(strings.Builder)
+	xml.EscapeText(buf, []byte(s))
+
+	return buf.String()
+}
+
+func sanitizeSheetName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+
+	if len(name) == 0 {
+		return "Sheet1"
+	}
+
+	if r := []rune(name); len(r) > maxSheetName {
+		name = string(r[:maxSheetName])
+	}
+
+	return name
+}
+
+const contentTypesXML = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`%s` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`<Override PartName="/xl/sharedStrings.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sharedStrings+xml"/>` +
+	`</Types>`
+
+const rootRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const workbookXML = xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets>%s</sheets>` +
+	`</workbook>`
+
+const workbookRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`%s` +
+	`<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/sharedStrings" Target="sharedStrings.xml"/>` +
+	`</Relationships>`
+
+const stylesXML = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="4"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill>` +
+	`<fill><patternFill patternType="solid"><fgColor rgb="FFD9E1F2"/><bgColor indexed="64"/></patternFill></fill>` +
+	`<fill><patternFill patternType="solid"><fgColor rgb="FFFFC7CE"/><bgColor indexed="64"/></patternFill></fill></fills>` +
+	`<borders count="2"><border><left/><right/><top/><bottom/><diagonal/></border>` +
+	`<border><left/><right/><top/><bottom style="thin"><color auto="1"/></bottom><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="3"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="2" borderId="1" xfId="0" applyFont="1" applyFill="1" applyBorder="1"/>` +
+	`<xf numFmtId="0" fontId="0" fillId="3" borderId="0" xfId="0" applyFill="1"/></cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
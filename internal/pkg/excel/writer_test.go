@@ -0,0 +1,155 @@
+package excel
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dev-pt-bai/cataloging/configs"
+)
+
+func TestWriterWrite(t *testing.T) {
+	header := []string{"Code", "Description"}
+	rows := [][]string{
+		{"ERSA", "Spare Parts"},
+		{"NLAG", `Non-stock <material> & "services"`},
+		{"ERSA", "Spare Parts\x01"},
+	}
+
+	data, err := NewWriter().Write("Material Types [export]", header, rows)
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	got, err := newParser(t, new(configs.Config)).Open(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	want := [][]string{
+		{"Code", "Description"},
+		{"ERSA", "Spare Parts"},
+		{"NLAG", `Non-stock <material> & "services"`},
+		{"ERSA", "Spare Parts"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Open(Write()) = %q, want %q", got, want)
+	}
+
+	zr, errZip := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if errZip != nil {
+		t.Fatalf("failed to open written file: %v", errZip)
+	}
+
+	contents := make(map[string]string, len(zr.File))
+	for _, f := range zr.File {
+		rc, _ := f.Open()
+		b, _ := io.ReadAll(rc)
+		rc.Close()
+		contents[f.Name] = string(b)
+	}
+
+	if !strings.Contains(contents["xl/workbook.xml"], `name="Material Types _export_"`) {
+		t.Errorf("workbook sheet name is not sanitized: %s", contents["xl/workbook.xml"])
+	}
+
+	sheet := contents["xl/worksheets/sheet1.xml"]
+	for _, s := range []string{`state="frozen"`, `<col min="2" max="2" width="35" customWidth="1"/>`, `<c r="A1" s="1" t="s">`} {
+		if !strings.Contains(sheet, s) {
+			t.Errorf("sheet does not contain %s", s)
+		}
+	}
+
+	if _, err := NewWriter().Write("Empty", nil, nil); err == nil {
+		t.Errorf("Write() without header returns nil error")
+	}
+}
+
+func TestWriterWriteHighlighted(t *testing.T) {
+	data, err := NewWriter().WriteHighlighted("Import Report", []string{"Row", "Status"}, [][]string{{"2", "created"}, {"3", "failed"}}, []int{1})
+	if err != nil {
+		t.Fatalf("WriteHighlighted() error = %v", err)
+	}
+
+	zr, errZip := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if errZip != nil {
+		t.Fatalf("failed to open written file: %v", errZip)
+	}
+
+	for _, f := range zr.File {
+		if f.Name != "xl/worksheets/sheet1.xml" {
+			continue
+		}
+		rc, _ := f.Open()
+		b, _ := io.ReadAll(rc)
+		rc.Close()
+
+		sheet := string(b)
+		if !strings.Contains(sheet, `<c r="A3" s="2" t="s">`) {
+			t.Errorf("failed row is not highlighted: %s", sheet)
+		}
+		if strings.Contains(sheet, `<c r="A2" s="2"`) {
+			t.Errorf("succeeded row is highlighted: %s", sheet)
+		}
+	}
+}
+
+func TestWriterWriteSheets(t *testing.T) {
+	data, err := NewWriter().WriteSheets([]Sheet{
+		{Name: "Materials", Header: []string{"Plant", "Long Text"}, Lists: []List{{Column: "Plant", Source: "Plants"}}},
+		{Name: "Plants", Header: []string{"Code", "Description"}, Rows: [][]string{{"1000", "Balikpapan"}, {"2000", "Jakarta"}}, Hidden: true},
+	})
+	if err != nil {
+		t.Fatalf("WriteSheets() error = %v", err)
+	}
+
+	p := newParser(t, new(configs.Config))
+	if got, err := p.Open(bytes.NewReader(data)); err != nil || !reflect.DeepEqual(got, [][]string{{"Plant", "Long Text"}}) {
+		t.Errorf("Open(WriteSheets()) = %q, %v", got, err)
+	}
+	if got, err := p.OpenSheet(bytes.NewReader(data), "Plants"); err != nil || len(got) != 3 || got[2][0] != "2000" {
+		t.Errorf("OpenSheet(WriteSheets(), Plants) = %q, %v", got, err)
+	}
+
+	zr, errZip := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if errZip != nil {
+		t.Fatalf("failed to open written file: %v", errZip)
+	}
+
+	contents := make(map[string]string, len(zr.File))
+	for _, f := range zr.File {
+		rc, _ := f.Open()
+		b, _ := io.ReadAll(rc)
+		rc.Close()
+		contents[f.Name] = string(b)
+	}
+
+	if !strings.Contains(contents["xl/workbook.xml"], `<sheet name="Plants" sheetId="2" state="hidden" r:id="rId2"/>`) {
+		t.Errorf("reference sheet is not hidden: %s", contents["xl/workbook.xml"])
+	}
+	if !strings.Contains(contents["xl/worksheets/sheet1.xml"], `sqref="A2:A1048576"><formula1>&#39;Plants&#39;!$A$2:$A$3</formula1>`) {
+		t.Errorf("sheet does not contain plant list validation: %s", contents["xl/worksheets/sheet1.xml"])
+	}
+
+	invalid := [][]Sheet{
+		{{Name: "Plants", Header: []string{"Code"}, Hidden: true}},
+		{{Name: "Plants", Header: []string{"Code"}}, {Name: "plants", Header: []string{"Code"}}},
+		{{Name: "Materials", Header: []string{"Plant"}, Lists: []List{{Column: "Plant", Source: "Plants"}}}},
+	}
+	for i := range invalid {
+		if _, err := NewWriter().WriteSheets(invalid[i]); err == nil {
+			t.Errorf("WriteSheets(%d) returns nil error", i)
+		}
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	for i, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 51: "AZ", 52: "BA", 701: "ZZ", 702: "AAA"} {
+		if got := columnName(i); got != want {
+			t.Errorf("columnName(%d) = %s, want %s", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package sap
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/dev-pt-bai/cataloging/configs"
+	"github.com/dev-pt-bai/cataloging/internal/model"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+)
+
+type NumberSource interface {
+	GetMaxMaterialNumber(ctx context.Context, from int64, to int64) (int64, *errors.Error)
+}
+
+type Fake struct {
+	mu       sync.Mutex
+	source   NumberSource
+	isSeeded bool
+	next     int64
+	last     int64
+}
+
+func NewFake(config *configs.Config, source NumberSource) (*Fake, error) {
+	if config == nil {
+		return nil, fmt.Errorf("missing config")
+	}
+
+	r := config.External.SAP.FakeNumberRange
+	if r.From < 1 || r.To < r.From {
+		return nil, fmt.Errorf("invalid SAP fake number range: %d-%d", r.From, r.To)
+	}
+
+	return &Fake{source: source, next: r.From, last: r.To}, nil
+}
+
+func (f *Fake) PublishMaterial(ctx context.Context, material model.Material) (string, *errors.Error) {
+	if material.Number != nil {
+		return *material.Number, nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.isSeeded {
+		assigned, err := f.source.GetMaxMaterialNumber(ctx, f.next, f.last)
+		if err != nil {
+			return "", err
+		}
+		f.next = max(f.next, assigned+1)
+		f.isSeeded = true
+	}
+
+	if f.next > f.last {
+		return "", errors.New(errors.MaterialNumberRangeExhausted).Wrap(fmt.Errorf("last number %d has been assigned", f.last))
+	}
+
+	number := strconv.FormatInt(f.next, 10)
+	f.next++
+
+	return number, nil
+}
@@ -0,0 +1,62 @@
+package sap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dev-pt-bai/cataloging/configs"
+	"github.com/dev-pt-bai/cataloging/internal/model"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+)
+
+type numberSource int64
+
+func (n numberSource) GetMaxMaterialNumber(ctx context.Context, from int64, to int64) (int64, *errors.Error) {
+	return int64(n), nil
+}
+
+func TestFakePublishMaterial(t *testing.T) {
+	config := new(configs.Config)
+	config.External.SAP.FakeNumberRange = configs.NumberRange{From: 10000000, To: 10000001}
+
+	f, err := NewFake(config, numberSource(0))
+	if err != nil {
+		t.Fatalf("NewFake() error = %v", err)
+	}
+
+	for _, want := range []string{"10000000", "10000001"} {
+		if got, err := f.PublishMaterial(context.Background(), model.Material{}); err != nil || got != want {
+			t.Errorf("PublishMaterial() = %s, %v, want %s", got, err, want)
+		}
+	}
+
+	existing := "10000123"
+	if got, err := f.PublishMaterial(context.Background(), model.Material{Number: &existing}); err != nil || got != existing {
+		t.Errorf("PublishMaterial() = %s, %v, want %s", got, err, existing)
+	}
+
+	if _, err := f.PublishMaterial(context.Background(), model.Material{}); !err.ContainsCodes(errors.MaterialNumberRangeExhausted) {
+		t.Errorf("PublishMaterial() error = %v, want %s", err, errors.MaterialNumberRangeExhausted)
+	}
+
+	config.External.SAP.FakeNumberRange = configs.NumberRange{From: 20, To: 10}
+	if _, err := NewFake(config, numberSource(0)); err == nil {
+		t.Errorf("NewFake() with invalid range returns nil error")
+	}
+}
+
+func TestFakePublishMaterialContinuesAfterAssignedNumbers(t *testing.T) {
+	config := new(configs.Config)
+	config.External.SAP.FakeNumberRange = configs.NumberRange{From: 10000000, To: 10000100}
+
+	f, err := NewFake(config, numberSource(10000041))
+	if err != nil {
+		t.Fatalf("NewFake() error = %v", err)
+	}
+
+	for _, want := range []string{"10000042", "10000043"} {
+		if got, err := f.PublishMaterial(context.Background(), model.Material{}); err != nil || got != want {
+			t.Errorf("PublishMaterial() = %s, %v, want %s", got, err, want)
+		}
+	}
+}
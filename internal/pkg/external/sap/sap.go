@@ -0,0 +1,169 @@
+package sap
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+
+	"github.com/dev-pt-bai/cataloging/configs"
+	"github.com/dev-pt-bai/cataloging/internal/model"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+)
+
+type Client struct {
+	username      string
+	password      string
+	urlProductAPI string
+	client        *http.Client
+}
+
+func NewClient(config *configs.Config) (*Client, error) {
+	c := new(Client)
+
+	if config == nil {
+		return nil, fmt.Errorf("missing config")
+	}
+
+	if len(config.External.SAP.ProductAPIURL) == 0 {
+		return nil, fmt.Errorf("missing SAP product API URL")
+	}
+	c.urlProductAPI = config.External.SAP.ProductAPIURL
+
+	if len(config.External.SAP.Username) == 0 {
+		return nil, fmt.Errorf("missing SAP username")
+	}
+	c.username = config.External.SAP.Username
+
+	if len(config.External.SAP.Password) == 0 {
+		return nil, fmt.Errorf("missing SAP password")
+	}
+	c.password = config.External.SAP.Password
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cookie jar: %w", err)
+	}
+	c.client = &http.Client{Jar: jar}
+
+	return c, nil
+}
+
+func (c *Client) PublishMaterial(ctx context.Context, material model.Material) (string, *errors.Error) {
+	token, err := c.fetchCSRFToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if material.Number != nil {
+		return *material.Number, c.updateDescription(ctx, token, *material.Number, material)
+	}
+
+	return c.createProduct(ctx, token, material)
+}
+
+func (c *Client) fetchCSRFToken(ctx context.Context) (string, *errors.Error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.urlProductAPI+"/", nil)
+	if err != nil {
+		return "", errors.New(errors.CreateHTTPRequestFailure).Wrap(err)
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("X-CSRF-Token", "Fetch")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return "", errors.New(errors.SendHTTPRequestFailure).Wrap(err)
+	}
+	defer res.Body.Close()
+
+	token := res.Header.Get("X-CSRF-Token")
+	if res.StatusCode != http.StatusOK || len(token) == 0 {
+		return "", errors.New(errors.FetchSAPCSRFTokenFailure).Wrap(fmt.Errorf("unexpected response status: %s", res.Status))
+	}
+
+	return token, nil
+}
+
+func (c *Client) createProduct(ctx context.Context, token string, material model.Material) (string, *errors.Error) {
+	body := new(bytes.Buffer)
+	if err := json.NewEncoder(body).Encode(model.NewSAPProduct(material)); err != nil {
+		return "", errors.New(errors.JSONEncodeFailure).Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.urlProductAPI+"/A_Product", body)
+	if err != nil {
+		return "", errors.New(errors.CreateHTTPRequestFailure).Wrap(err)
+	}
+	c.setHeaders(req, token)
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return "", errors.New(errors.SendHTTPRequestFailure).Wrap(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		return "", c.parseError(res)
+	}
+
+	r := new(model.SAPProductResponse)
+	if err := json.NewDecoder(res.Body).Decode(r); err != nil {
+		return "", errors.New(errors.JSONDecodeFailure).Wrap(err)
+	}
+
+	if r.Data == nil || len(r.Data.Product) == 0 {
+		return "", errors.New(errors.PublishMaterialFailure).Wrap(fmt.Errorf("missing product number in response"))
+	}
+
+	return r.Data.Product, nil
+}
+
+func (c *Client) updateDescription(ctx context.Context, token string, number string, material model.Material) *errors.Error {
+	p := model.NewSAPProduct(material)
+	if len(p.Description.Results) == 0 {
+		return nil
+	}
+
+	body := new(bytes.Buffer)
+	if err := json.NewEncoder(body).Encode(p.Description.Results[0]); err != nil {
+		return errors.New(errors.JSONEncodeFailure).Wrap(err)
+	}
+
+	u := fmt.Sprintf("%s/A_ProductDescription(Product='%s',Language='%s')", c.urlProductAPI, url.PathEscape(number), p.Description.Results[0].Language)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, u, body)
+	if err != nil {
+		return errors.New(errors.CreateHTTPRequestFailure).Wrap(err)
+	}
+	c.setHeaders(req, token)
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return errors.New(errors.SendHTTPRequestFailure).Wrap(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		return c.parseError(res)
+	}
+
+	return nil
+}
+
+func (c *Client) setHeaders(req *http.Request, token string) {
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("X-CSRF-Token", token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+}
+
+func (c *Client) parseError(res *http.Response) *errors.Error {
+	r := new(model.SAPErrorResponse)
+	if err := json.NewDecoder(res.Body).Decode(r); err != nil || r.Error == nil {
+		return errors.New(errors.PublishMaterialFailure).Wrap(fmt.Errorf("unexpected response status: %s", res.Status))
+	}
+
+	return errors.New(errors.PublishMaterialFailure).Wrap(r.Error)
+}
@@ -0,0 +1,291 @@
+package idoc
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dev-pt-bai/cataloging/configs"
+	"github.com/dev-pt-bai/cataloging/internal/model"
+)
+
+const (
+	IDocType    = "MATMAS05"
+	MessageType = "MATMAS"
+	maxTextLine = 132
+	language    = "E"
+	languageISO = "EN"
+	partnerType = "LS"
+	msgFunction = "005"
+	inbound     = "2"
+)
+
+type Builder struct {
+	client          string
+	senderPort      string
+	senderPartner   string
+	receiverPort    string
+	receiverPartner string
+}
+
+func NewBuilder(config *configs.Config) (*Builder, error) {
+	b := new(Builder)
+
+	if config == nil {
+		return nil, fmt.Errorf("missing config")
+	}
+
+	if len(config.External.SAP.Client) == 0 {
+		return nil, fmt.Errorf("missing SAP client")
+	}
+	b.client = config.External.SAP.Client
+
+	if len(config.External.SAP.SenderPort) == 0 {
+		return nil, fmt.Errorf("missing SAP sender port")
+	}
+	b.senderPort = config.External.SAP.SenderPort
+
+	if len(config.External.SAP.SenderPartner) == 0 {
+		return nil, fmt.Errorf("missing SAP sender partner")
+	}
+	b.senderPartner = config.External.SAP.SenderPartner
+
+	if len(config.External.SAP.ReceiverPort) == 0 {
+		return nil, fmt.Errorf("missing SAP receiver port")
+	}
+	b.receiverPort = config.External.SAP.ReceiverPort
+
+	if len(config.External.SAP.ReceiverPartner) == 0 {
+		return nil, fmt.Errorf("missing SAP receiver partner")
+	}
+	b.receiverPartner = config.External.SAP.ReceiverPartner
+
+	return b, nil
+}
+
+type Document struct {
+	XMLName xml.Name `xml:"MATMAS05"`
+	IDocs   []IDoc   `xml:"IDOC"`
+}
+
+type IDoc struct {
+	Begin   string         `xml:"BEGIN,attr"`
+	Control ControlRecord  `xml:"EDI_DC40"`
+	Basic   BasicDataEntry `xml:"E1MARAM"`
+}
+
+type ControlRecord struct {
+	Segment     string `xml:"SEGMENT,attr"`
+	TableName   string `xml:"TABNAM"`
+	Client      string `xml:"MANDT"`
+	Number      string `xml:"DOCNUM"`
+	Direction   string `xml:"DIRECT"`
+	IDocType    string `xml:"IDOCTYP"`
+	MessageType string `xml:"MESTYP"`
+	SndPort     string `xml:"SNDPOR"`
+	SndPrtType  string `xml:"SNDPRT"`
+	SndPartner  string `xml:"SNDPRN"`
+	RcvPort     string `xml:"RCVPOR"`
+	RcvPrtType  string `xml:"RCVPRT"`
+	RcvPartner  string `xml:"RCVPRN"`
+	CreatedDate string `xml:"CREDAT"`
+	CreatedTime string `xml:"CRETIM"`
+}
+
+type BasicDataEntry struct {
+	Segment         string           `xml:"SEGMENT,attr"`
+	Function        string           `xml:"MSGFN"`
+	Number          string           `xml:"MATNR,omitempty"`
+	Type            string           `xml:"MTART"`
+	Group           string           `xml:"MATKL"`
+	BaseUoM         string           `xml:"MEINS"`
+	Manufacturer    string           `xml:"MFRNR,omitempty"`
+	PartNumber      string           `xml:"MFRPN,omitempty"`
+	Descriptions    []Description    `xml:"E1MAKTM"`
+	Plants          []PlantData      `xml:"E1MARCM"`
+	UnitsOfMeasure  []UnitOfMeasure  `xml:"E1MARMM"`
+	Valuations      []Valuation      `xml:"E1MBEWM"`
+	LongTextHeaders []LongTextHeader `xml:"E1MTXHM"`
+}
+
+type Description struct {
+	Segment     string `xml:"SEGMENT,attr"`
+	Function    string `xml:"MSGFN"`
+	Language    string `xml:"SPRAS"`
+	Text        string `xml:"MAKTX"`
+	LanguageISO string `xml:"SPRAS_ISO"`
+}
+
+type PlantData struct {
+	Segment  string `xml:"SEGMENT,attr"`
+	Function string `xml:"MSGFN"`
+	Plant    string `xml:"WERKS"`
+}
+
+type UnitOfMeasure struct {
+	Segment     string `xml:"SEGMENT,attr"`
+	Function    string `xml:"MSGFN"`
+	UoM         string `xml:"MEINH"`
+	Numerator   string `xml:"UMREZ"`
+	Denominator string `xml:"UMREN"`
+}
+
+type Valuation struct {
+	Segment        string `xml:"SEGMENT,attr"`
+	Function       string `xml:"MSGFN"`
+	ValuationArea  string `xml:"BWKEY"`
+	ValuationClass string `xml:"BKLAS,omitempty"`
+}
+
+type LongTextHeader struct {
+	Segment     string         `xml:"SEGMENT,attr"`
+	Function    string         `xml:"MSGFN"`
+	Object      string         `xml:"TDOBJECT"`
+	Name        string         `xml:"TDNAME,omitempty"`
+	ID          string         `xml:"TDID"`
+	Language    string         `xml:"TDSPRAS"`
+	LanguageISO string         `xml:"SPRAS_ISO"`
+	Lines       []LongTextLine `xml:"E1MTXLM"`
+}
+
+type LongTextLine struct {
+	Segment  string `xml:"SEGMENT,attr"`
+	Function string `xml:"MSGFN"`
+	Format   string `xml:"TDFORMAT"`
+	Line     string `xml:"TDLINE"`
+}
+
+func (b *Builder) Build(materials []model.Material, createdAt time.Time) ([]byte, error) {
+	if len(materials) == 0 {
+		return nil, fmt.Errorf("missing materials")
+	}
+
+	doc := Document{IDocs: make([]IDoc, 0, len(materials))}
+	for i := range materials {
+		doc.IDocs = append(doc.IDocs, IDoc{
+			Begin:   "1",
+			Control: b.controlRecord(createdAt, i),
+			Basic:   basicDataEntry(materials[i]),
+		})
+	}
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", IDocType, err)
+	}
+
+	return append([]byte(xml.Header), data...), nil
+}
+
+func (b *Builder) controlRecord(createdAt time.Time, index int) ControlRecord {
+	return ControlRecord{
+		Segment:     "1",
+		TableName:   "EDI_DC40",
+		Client:      b.client,
+		Number:      fmt.Sprintf("%016d", createdAt.Unix()*1000000+int64(index)),
+		Direction:   inbound,
+		IDocType:    IDocType,
+		MessageType: MessageType,
+		SndPort:     b.senderPort,
+		SndPrtType:  partnerType,
+		SndPartner:  b.senderPartner,
+		RcvPort:     b.receiverPort,
+		RcvPrtType:  partnerType,
+		RcvPartner:  b.receiverPartner,
+		CreatedDate: createdAt.Format("20060102"),
+		CreatedTime: createdAt.Format("150405"),
+	}
+}
+
+func basicDataEntry(m model.Material) BasicDataEntry {
+	e := BasicDataEntry{
+		Segment:  "1",
+		Function: msgFunction,
+		Type:     m.Type.Code,
+		Group:    m.Group.Code,
+		BaseUoM:  m.UoM.Code,
+		Plants: []PlantData{{
+			Segment:  "1",
+			Function: msgFunction,
+			Plant:    m.Plant.Code,
+		}},
+		UnitsOfMeasure: []UnitOfMeasure{{
+			Segment:     "1",
+			Function:    msgFunction,
+			UoM:         m.UoM.Code,
+			Numerator:   "1",
+			Denominator: "1",
+		}},
+		Valuations: []Valuation{{
+			Segment:        "1",
+			Function:       msgFunction,
+			ValuationArea:  m.Plant.Code,
+			ValuationClass: deref(m.Type.ValuationClass),
+		}},
+	}
+
+	e.Number = deref(m.Number)
+	e.PartNumber = deref(m.PartNumber)
+	if m.Manufacturer != nil {
+		e.Manufacturer = m.Manufacturer.Code
+	}
+
+	if m.ShortText != nil {
+		e.Descriptions = append(e.Descriptions, Description{
+			Segment:     "1",
+			Function:    msgFunction,
+			Language:    language,
+			Text:        *m.ShortText,
+			LanguageISO: languageISO,
+		})
+	}
+
+	if lines := splitLongText(m.LongText); len(lines) > 0 {
+		header := LongTextHeader{
+			Segment:     "1",
+			Function:    msgFunction,
+			Object:      "MATERIAL",
+			Name:        e.Number,
+			ID:          "GRUN",
+			Language:    language,
+			LanguageISO: languageISO,
+			Lines:       make([]LongTextLine, 0, len(lines)),
+		}
+		for i := range lines {
+			header.Lines = append(header.Lines, LongTextLine{
+				Segment:  "1",
+				Function: msgFunction,
+				Format:   "*",
+				Line:     lines[i],
+			})
+		}
+		e.LongTextHeaders = append(e.LongTextHeaders, header)
+	}
+
+	return e
+}
+
+func splitLongText(text string) []string {
+	lines := make([]string, 0, 5)
+	for _, l := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		runes := []rune(strings.TrimSpace(l))
+		for len(runes) > maxTextLine {
+			lines = append(lines, string(runes[:maxTextLine]))
+			runes = runes[maxTextLine:]
+		}
+		if len(runes) > 0 {
+			lines = append(lines, string(runes))
+		}
+	}
+
+	return lines
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
@@ -0,0 +1,106 @@
+package idoc
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dev-pt-bai/cataloging/configs"
+	"github.com/dev-pt-bai/cataloging/internal/model"
+)
+
+func TestBuild(t *testing.T) {
+	config := new(configs.Config)
+	config.External.SAP = configs.SAP{
+		Client:          "100",
+		SenderPort:      "CATALOGING",
+		SenderPartner:   "CATCLNT100",
+		ReceiverPort:    "SAPPRD",
+		ReceiverPartner: "PRDCLNT100",
+	}
+
+	b, err := NewBuilder(config)
+	if err != nil {
+		t.Fatalf("NewBuilder() error = %v", err)
+	}
+
+	number := "10000123"
+	shortText := "BRG,BALL,6205-2RS"
+	valuationClass := "3040"
+	materials := []model.Material{
+		{
+			Number:       &number,
+			Plant:        model.Plant{Code: "P001"},
+			Type:         model.MaterialType{Code: "ERSA", ValuationClass: &valuationClass},
+			UoM:          model.MaterialUoM{Code: "EA"},
+			Group:        model.MaterialGroup{Code: "G01"},
+			Manufacturer: &model.Manufacturer{Code: "SKF"},
+			ShortText:    &shortText,
+			LongText:     "BEARING, BALL; " + strings.Repeat("X", 140) + "\nSEALED BOTH SIDES",
+		},
+		{
+			Plant:    model.Plant{Code: "P002"},
+			Type:     model.MaterialType{Code: "NLAG"},
+			UoM:      model.MaterialUoM{Code: "PC"},
+			Group:    model.MaterialGroup{Code: "G02"},
+			LongText: "GASKET, SPIRAL WOUND",
+		},
+	}
+
+	createdAt := time.Date(2025, time.March, 4, 13, 5, 6, 0, time.UTC)
+	data, err := b.Build(materials, createdAt)
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	if !strings.HasPrefix(string(data), xml.Header) {
+		t.Errorf("Build() does not start with XML header")
+	}
+
+	doc := new(Document)
+	if err := xml.Unmarshal(data, doc); err != nil {
+		t.Fatalf("failed to unmarshal Build() result: %v", err)
+	}
+
+	if len(doc.IDocs) != 2 {
+		t.Fatalf("Build() returns %d IDocs, want 2", len(doc.IDocs))
+	}
+
+	c := doc.IDocs[0].Control
+	if c.IDocType != "MATMAS05" || c.MessageType != "MATMAS" || c.Direction != "2" || c.Client != "100" {
+		t.Errorf("Build() control record = %+v", c)
+	}
+	if c.SndPartner != "CATCLNT100" || c.RcvPartner != "PRDCLNT100" || c.CreatedDate != "20250304" || c.CreatedTime != "130506" {
+		t.Errorf("Build() control record = %+v", c)
+	}
+	if len(c.Number) != 16 || c.Number == doc.IDocs[1].Control.Number {
+		t.Errorf("Build() document numbers = %s, %s", c.Number, doc.IDocs[1].Control.Number)
+	}
+
+	e := doc.IDocs[0].Basic
+	if e.Number != number || e.Type != "ERSA" || e.Group != "G01" || e.BaseUoM != "EA" || e.Manufacturer != "SKF" {
+		t.Errorf("Build() basic data = %+v", e)
+	}
+	if len(e.Descriptions) != 1 || e.Descriptions[0].Text != shortText {
+		t.Errorf("Build() descriptions = %+v", e.Descriptions)
+	}
+	if len(e.Plants) != 1 || e.Plants[0].Plant != "P001" {
+		t.Errorf("Build() plants = %+v", e.Plants)
+	}
+	if len(e.Valuations) != 1 || e.Valuations[0].ValuationArea != "P001" || e.Valuations[0].ValuationClass != valuationClass {
+		t.Errorf("Build() valuations = %+v", e.Valuations)
+	}
+	if len(e.LongTextHeaders) != 1 || len(e.LongTextHeaders[0].Lines) != 3 || len([]rune(e.LongTextHeaders[0].Lines[0].Line)) != 132 {
+		t.Errorf("Build() long texts = %+v", e.LongTextHeaders)
+	}
+
+	e = doc.IDocs[1].Basic
+	if len(e.Number) != 0 || len(e.Descriptions) != 0 || len(e.Valuations[0].ValuationClass) != 0 {
+		t.Errorf("Build() basic data = %+v", e)
+	}
+
+	if _, err := b.Build(nil, createdAt); err == nil {
+		t.Errorf("Build() with no materials returns nil error")
+	}
+}
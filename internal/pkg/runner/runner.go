@@ -37,6 +37,8 @@ import (
 	"github.com/dev-pt-bai/cataloging/internal/pkg/database/sql"
 	"github.com/dev-pt-bai/cataloging/internal/pkg/excel"
 	"github.com/dev-pt-bai/cataloging/internal/pkg/external/msgraph"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/external/sap"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/tabular"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -116,9 +118,20 @@ func (a *App) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to instantiate authentication handler: %w", err)
 	}
 
-	excelParser := excel.NewParser()
+	tabularReader, err := tabular.NewReader(config)
+	if err != nil {
+		return fmt.Errorf("failed to instantiate tabular reader: %w", err)
+	}
+	excelWriter := excel.NewWriter()
 	materialRepository := mrepository.New(db)
-	materialService := mservice.New(materialRepository, excelParser)
+	materialService, err := mservice.New(materialRepository, tabularReader, excelWriter, taskManager, config)
+	if err != nil {
+		return fmt.Errorf("failed to instantiate material service: %w", err)
+	}
+	taskManager.HandleFunc(config.App.Async.TaskTypes.ProcessImport, materialService.ProcessImportJob)
+	if config.App.Spreadsheet.ImportExpiryIntervalSec > 0 {
+		scheduler.HandleFunc("expire-import-jobs", config.App.Spreadsheet.ImportExpiryIntervalSec, materialService.ExpireImportJobs)
+	}
 	materialHandler := mhandler.New(materialService)
 
 	assetRepository := asrepository.New(db)
@@ -129,7 +142,25 @@ func (a *App) Start(ctx context.Context) error {
 	}
 
 	requestRepository := rrepository.New(db)
-	requestService := rservice.New(requestRepository, taskManager)
+	var sapPublisher rservice.Publisher
+	switch {
+	case config.External.SAP.Publisher == "fake":
+		sapPublisher, err = sap.NewFake(config, requestRepository)
+	case len(config.External.SAP.ProductAPIURL) != 0:
+		sapPublisher, err = sap.NewClient(config)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to instantiate SAP publisher: %w", err)
+	}
+
+	requestService, err := rservice.New(requestRepository, taskManager, sapPublisher, tabularReader, excelWriter, config)
+	if err != nil {
+		return fmt.Errorf("failed to instantiate request service: %w", err)
+	}
+	taskManager.HandleFunc(config.App.Async.TaskTypes.PublishRequest, requestService.PublishRequest)
+	if config.App.SLA.EscalationIntervalSec > 0 {
+		scheduler.HandleFunc("escalate-overdue-requests", config.App.SLA.EscalationIntervalSec, requestService.EscalateOverdueRequests)
+	}
 	requestHandler := rhandler.New(requestService)
 
 	a.mux = http.NewServeMux()
@@ -287,9 +318,62 @@ func (a *App) register(
 	a.mux.HandleFunc("GET /manufacturers/{code}", mhandler.GetManufacturer)
 	a.mux.HandleFunc("PUT /manufacturers/{code}", mhandler.UpdateManufacturer)
 	a.mux.HandleFunc("DELETE /manufacturers/{code}", mhandler.DeleteManufacturer)
+	a.mux.HandleFunc("POST /plants/{code}/material_types", mhandler.CreatePlantMaterialType)
+	a.mux.HandleFunc("GET /plants/{code}/material_types", mhandler.ListPlantMaterialTypes)
+	a.mux.HandleFunc("DELETE /plants/{code}/material_types/{typeCode}", mhandler.DeletePlantMaterialType)
+	a.mux.HandleFunc("POST /material_types/{code}/material_groups", mhandler.CreateMaterialTypeGroup)
+	a.mux.HandleFunc("GET /material_types/{code}/material_groups", mhandler.ListMaterialTypeGroups)
+	a.mux.HandleFunc("DELETE /material_types/{code}/material_groups/{groupCode}", mhandler.DeleteMaterialTypeGroup)
+	a.mux.HandleFunc("GET /materials", mhandler.ListMaterials)
+	a.mux.HandleFunc("GET /materials/{id}", mhandler.GetMaterial)
+	a.mux.HandleFunc("GET /materials/numbers/{number}", mhandler.ListMaterialsByNumber)
+	a.mux.HandleFunc("POST /abbreviations", mhandler.CreateAbbreviation)
+	a.mux.HandleFunc("GET /abbreviations", mhandler.ListAbbreviations)
+	a.mux.HandleFunc("GET /abbreviations/{term}", mhandler.GetAbbreviation)
+	a.mux.HandleFunc("PUT /abbreviations/{term}", mhandler.UpdateAbbreviation)
+	a.mux.HandleFunc("DELETE /abbreviations/{term}", mhandler.DeleteAbbreviation)
+	a.mux.HandleFunc("POST /short_texts/preview", mhandler.PreviewShortText)
 	a.mux.HandleFunc("POST /requests", rhandler.CreateRequest)
+	a.mux.HandleFunc("GET /requests", rhandler.ListRequests)
+	a.mux.HandleFunc("GET /requests/assignments", rhandler.ListAssignedRequests)
+	a.mux.HandleFunc("POST /requests/claim", rhandler.ClaimRequest)
+	a.mux.HandleFunc("POST /requests/idoc", rhandler.ExportRequestsIDoc)
 	a.mux.HandleFunc("GET /requests/{id}", rhandler.GetRequest)
+	a.mux.HandleFunc("PUT /requests/{id}", rhandler.UpdateRequest)
+	a.mux.HandleFunc("DELETE /requests/{id}", rhandler.DeleteRequest)
+	a.mux.HandleFunc("GET /requests/{id}/history", rhandler.GetRequestHistory)
+	a.mux.HandleFunc("GET /requests/{id}/duplicates", rhandler.FindDuplicates)
+	a.mux.HandleFunc("GET /requests/{id}/diff", rhandler.GetRequestDiff)
+	a.mux.HandleFunc("GET /requests/{id}/export", rhandler.ExportRequest)
+	a.mux.HandleFunc("GET /requests/{id}/idoc", rhandler.ExportRequestIDoc)
+	a.mux.HandleFunc("PATCH /requests/{id}/assignee", rhandler.AssignRequest)
+	a.mux.HandleFunc("PATCH /requests/{id}/submission", rhandler.SubmitRequest)
+	a.mux.HandleFunc("PATCH /requests/{id}/processing", rhandler.ProcessRequest)
+	a.mux.HandleFunc("PATCH /requests/{id}/approval", rhandler.ApproveRequest)
+	a.mux.HandleFunc("PATCH /requests/{id}/rejection", rhandler.RejectRequest)
+	a.mux.HandleFunc("PATCH /requests/{id}/publication", rhandler.PublishRequest)
+	a.mux.HandleFunc("PATCH /requests/{id}/deprecation", rhandler.DeprecateRequest)
+	a.mux.HandleFunc("PATCH /requests/{id}/materials/{materialID}/review", rhandler.ReviewMaterial)
+	a.mux.HandleFunc("PATCH /requests/{id}/materials/{materialID}/duplicate", rhandler.MarkMaterialDuplicate)
+	a.mux.HandleFunc("POST /requests/{id}/comments", rhandler.CreateComment)
+	a.mux.HandleFunc("GET /requests/{id}/comments", rhandler.ListComments)
+	a.mux.HandleFunc("PUT /requests/{id}/comments/{commentID}", rhandler.UpdateComment)
+	a.mux.HandleFunc("DELETE /requests/{id}/comments/{commentID}", rhandler.DeleteComment)
+	a.mux.HandleFunc("POST /bulk/material_types", mhandler.BulkCreateMaterialType)
+	a.mux.HandleFunc("POST /bulk/material_uoms", mhandler.BulkCreateMaterialUoM)
+	a.mux.HandleFunc("POST /bulk/material_groups", mhandler.BulkCreateMaterialGroup)
+	a.mux.HandleFunc("POST /bulk/plants", mhandler.BulkCreatePlant)
 	a.mux.HandleFunc("POST /bulk/manufacturers", mhandler.BulkCreateManufacturer)
+	a.mux.HandleFunc("POST /bulk/requests", rhandler.BulkCreateRequest)
+	a.mux.HandleFunc("POST /imports", mhandler.CreateImportJob)
+	a.mux.HandleFunc("GET /imports/{id}", mhandler.GetImportJob)
+	a.mux.HandleFunc("POST /imports/{id}/commit", mhandler.CommitImportJob)
+	a.mux.HandleFunc("GET /export/material_types", mhandler.ExportMaterialTypes)
+	a.mux.HandleFunc("GET /export/material_uoms", mhandler.ExportMaterialUoMs)
+	a.mux.HandleFunc("GET /export/material_groups", mhandler.ExportMaterialGroups)
+	a.mux.HandleFunc("GET /export/plants", mhandler.ExportPlants)
+	a.mux.HandleFunc("GET /export/manufacturers", mhandler.ExportManufacturers)
+	a.mux.HandleFunc("GET /templates/request.xlsx", mhandler.ExportRequestTemplate)
 }
 
 func (a *App) Stop() error {
@@ -0,0 +1,85 @@
+package similarity
+
+import (
+	"strings"
+	"unicode"
+)
+
+func Normalize(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	isSpace := true
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			isSpace = false
+			continue
+		}
+		if !isSpace {
+			b.WriteRune(' ')
+			isSpace = true
+		}
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+func Compact(s string) string {
+	return strings.ReplaceAll(Normalize(s), " ", "")
+}
+
+func Tokens(s string) map[string]struct{} {
+	fields := strings.Fields(Normalize(s))
+	tokens := make(map[string]struct{}, len(fields))
+	for i := range fields {
+		tokens[fields[i]] = struct{}{}
+	}
+
+	return tokens
+}
+
+func Trigrams(s string) map[string]struct{} {
+	fields := strings.Fields(Normalize(s))
+	trigrams := make(map[string]struct{}, len(s))
+	for i := range fields {
+		r := []rune("  " + fields[i] + " ")
+		for j := 0; j+3 <= len(r); j++ {
+			trigrams[string(r[j:j+3])] = struct{}{}
+		}
+	}
+
+	return trigrams
+}
+
+func Jaccard(a, b map[string]struct{}) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+
+	intersection := 0
+	for k := range a {
+		if _, exists := b[k]; exists {
+			intersection++
+		}
+	}
+
+	return float64(intersection) / float64(len(a)+len(b)-intersection)
+}
+
+func Text(a, b string) float64 {
+	return (Jaccard(Tokens(a), Tokens(b)) + Jaccard(Trigrams(a), Trigrams(b))) / 2
+}
+
+func Code(a, b string) float64 {
+	a, b = Compact(a), Compact(b)
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	if a == b {
+		return 1
+	}
+
+	return Jaccard(Trigrams(a), Trigrams(b))
+}
@@ -0,0 +1,72 @@
+package similarity
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "empty", s: "", want: ""},
+		{name: "punctuation", s: "BEARING, BALL; 6205-2RS", want: "bearing ball 6205 2rs"},
+		{name: "repeated separators", s: "  Valve -- Gate  (2\")  ", want: "valve gate 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.s); got != tt.want {
+				t.Errorf("Normalize() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want float64
+	}{
+		{name: "identical", a: "BEARING, BALL 6205-2RS", b: "bearing ball 6205 2rs", want: 1},
+		{name: "unrelated", a: "BEARING", b: "GASKET", want: 0},
+		{name: "empty", a: "", b: "", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Text(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Text() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if Text("BEARING BALL 6205", "BEARING BALL 6206") <= Text("BEARING BALL 6205", "GASKET SPIRAL WOUND") {
+		t.Errorf("Text() should rank similar descriptions higher")
+	}
+}
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want float64
+	}{
+		{name: "same code with different separators", a: "6205-2RS", b: "6205 2rs", want: 1},
+		{name: "missing code", a: "6205-2RS", b: "", want: 0},
+		{name: "different code", a: "ABC", b: "XYZ", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Code(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Code() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
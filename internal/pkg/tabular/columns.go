@@ -0,0 +1,57 @@
+package tabular
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+)
+
+type Columns struct {
+	indexes map[string]int
+}
+
+func NewColumns(header []string, known []string) (*Columns, *errors.Error) {
+	c := &Columns{indexes: make(map[string]int, len(known))}
+	for i := range known {
+		c.indexes[known[i]] = -1
+	}
+
+	for i := range header {
+		name := strings.TrimSpace(header[i])
+		if len(name) == 0 {
+			continue
+		}
+
+		idx, exists := c.indexes[name]
+		if !exists {
+			return nil, errors.New(errors.UnknownField).Wrap(fmt.Errorf("unknown column: %s", name))
+		}
+		if idx >= 0 {
+			return nil, errors.New(errors.DuplicateSpreadsheetColumn).Wrap(fmt.Errorf("duplicate column: %s", name))
+		}
+		c.indexes[name] = i
+	}
+
+	return c, nil
+}
+
+func (c *Columns) Value(row []string, column string) string {
+	if idx, exists := c.indexes[column]; exists && idx >= 0 && idx < len(row) {
+		return strings.TrimSpace(row[idx])
+	}
+
+	return ""
+}
+
+func (c *Columns) Optional(row []string, column string) *string {
+	if v := c.Value(row, column); len(v) > 0 {
+		return &v
+	}
+
+	return nil
+}
+
+func IsBlank(row []string) bool {
+	return len(strings.TrimSpace(strings.Join(row, ""))) == 0
+}
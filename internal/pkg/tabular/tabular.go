@@ -0,0 +1,255 @@
+package tabular
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/dev-pt-bai/cataloging/configs"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/excel"
+)
+
+const (
+	sniffSize = 64 << 10
+)
+
+var (
+	zipSignature = []byte("PK\x03\x04")
+	utf8BOM      = []byte("\xef\xbb\xbf")
+)
+
+type Format string
+
+const (
+	XLSX Format = "xlsx"
+	CSV  Format = "csv"
+	TSV  Format = "tsv"
+)
+
+type RowReader interface {
+	Next() bool
+	Row() []string
+	RowNumber() int
+	Err() *errors.Error
+	Close() error
+}
+
+type Reader struct {
+	excelParser *excel.Parser
+	maxFileSize int64
+	maxRows     int
+}
+
+func NewReader(config *configs.Config) (*Reader, error) {
+	if config == nil {
+		return nil, fmt.Errorf("missing config")
+	}
+
+	excelParser, err := excel.NewParser(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{excelParser: excelParser, maxFileSize: excelParser.MaxFileSize(), maxRows: excelParser.MaxRows()}, nil
+}
+
+func (r *Reader) MaxFileSize() int64 {
+	return r.maxFileSize
+}
+
+func (r *Reader) Open(src io.Reader, filename string) ([][]string, *errors.Error) {
+	rr, err := r.NewRowReader(src, filename)
+	if err != nil {
+		return nil, err
+	}
+	defer rr.Close()
+
+	rows := make([][]string, 0)
+	for rr.Next() {
+		for len(rows) < rr.RowNumber()-1 {
+			rows = append(rows, []string{})
+		}
+		rows = append(rows, rr.Row())
+	}
+
+	if err := rr.Err(); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (r *Reader) NewRowReader(src io.Reader, filename string) (RowReader, *errors.Error) {
+	br := bufio.NewReaderSize(src, sniffSize)
+	head, err := br.Peek(sniffSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, errors.New(errors.ParsingFileFailure).Wrap(err)
+	}
+
+	format, delimiter, err := detect(head, filename)
+	if err != nil {
+		return nil, errors.New(errors.ParsingFileFailure).Wrap(err)
+	}
+
+	if format == XLSX {
+		rr, errOpen := r.excelParser.NewRowReader(br, "")
+		if errOpen != nil {
+			return nil, errOpen
+		}
+		return rr, nil
+	}
+
+	if bytes.HasPrefix(head, utf8BOM) {
+		br.Discard(len(utf8BOM))
+	}
+
+	lr := &limitedReader{r: br, remaining: r.maxFileSize}
+	cr := csv.NewReader(lr)
+	cr.Comma = delimiter
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	return &delimitedReader{reader: cr, limit: lr, maxRows: r.maxRows}, nil
+}
+
+func detect(head []byte, filename string) (Format, rune, error) {
+	ext := strings.ToLower(filepath.Ext(filename))
+
+	if bytes.HasPrefix(head, zipSignature) {
+		if ext != "" && ext != ".xlsx" && ext != ".xlsm" {
+			return "", 0, fmt.Errorf("unsupported file extension: %s", ext)
+		}
+		return XLSX, 0, nil
+	}
+
+	switch ext {
+	case ".xlsx", ".xlsm":
+		return "", 0, fmt.Errorf("file %s is not a valid xlsx workbook", filename)
+	case ".tsv", ".tab":
+		return TSV, '\t', nil
+	case "", ".csv", ".txt":
+	default:
+		return "", 0, fmt.Errorf("unsupported file extension: %s", ext)
+	}
+
+	delimiter := sniffDelimiter(bytes.TrimPrefix(head, utf8BOM))
+	if delimiter == '\t' {
+		return TSV, delimiter, nil
+	}
+
+	return CSV, delimiter, nil
+}
+
+func sniffDelimiter(head []byte) rune {
+	counts := map[rune]int{',': 0, ';': 0, '\t': 0}
+	inQuote := false
+	for _, r := range string(head) {
+		if r == '"' {
+			inQuote = !inQuote
+			continue
+		}
+		if inQuote {
+			continue
+		}
+		if r == '\n' {
+			break
+		}
+		if _, exists := counts[r]; exists {
+			counts[r]++
+		}
+	}
+
+	delimiter := ','
+	for _, r := range []rune{'\t', ';'} {
+		if counts[r] > counts[delimiter] {
+			delimiter = r
+		}
+	}
+
+	return delimiter
+}
+
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+	exceeded  bool
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining < 0 {
+		l.exceeded = true
+		return 0, fmt.Errorf("file exceeds maximum size")
+	}
+
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		l.exceeded = true
+		return 0, fmt.Errorf("file exceeds maximum size")
+	}
+
+	return n, err
+}
+
+type delimitedReader struct {
+	reader  *csv.Reader
+	limit   *limitedReader
+	maxRows int
+	row     []string
+	number  int
+	err     *errors.Error
+}
+
+func (d *delimitedReader) Next() bool {
+	if d.err != nil {
+		return false
+	}
+
+	record, err := d.reader.Read()
+	if err == io.EOF {
+		return false
+	}
+	if err != nil {
+		if d.limit.exceeded {
+			d.err = errors.New(errors.FileOversize).Wrap(err)
+			return false
+		}
+		d.err = errors.New(errors.ParsingFileFailure).Wrap(err)
+		return false
+	}
+
+	if d.number+1 > d.maxRows {
+		d.err = errors.New(errors.TooManyRows).Wrap(fmt.Errorf("file exceeds %d rows", d.maxRows))
+		return false
+	}
+
+	d.number++
+	d.row = record
+
+	return true
+}
+
+func (d *delimitedReader) Row() []string {
+	return d.row
+}
+
+func (d *delimitedReader) RowNumber() int {
+	return d.number
+}
+
+func (d *delimitedReader) Err() *errors.Error {
+	return d.err
+}
+
+func (d *delimitedReader) Close() error {
+	return nil
+}
@@ -0,0 +1,154 @@
+package tabular
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dev-pt-bai/cataloging/configs"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/errors"
+	"github.com/dev-pt-bai/cataloging/internal/pkg/excel"
+)
+
+func TestReaderOpen(t *testing.T) {
+	r, err := NewReader(new(configs.Config))
+	if err != nil {
+		t.Fatalf("NewReader() error = %v", err)
+	}
+
+	xlsx, errWrite := excel.NewWriter().Write("Manufacturers", []string{"Code", "Description"}, [][]string{{"SHL", "Shell"}})
+	if errWrite != nil {
+		t.Fatalf("Write() error = %v", errWrite)
+	}
+
+	template, errWrite := excel.NewWriter().WriteSheets([]excel.Sheet{
+		{Name: "Materials", Header: []string{"Plant", "Long Text"}, Rows: [][]string{{"1000", "BEARING"}}, Lists: []excel.List{{Column: "Plant", Source: "Plants"}}},
+		{Name: "Plants", Header: []string{"Code", "Description"}, Rows: [][]string{{"1000", "Balikpapan"}}, Hidden: true},
+	})
+	if errWrite != nil {
+		t.Fatalf("WriteSheets() error = %v", errWrite)
+	}
+
+	tests := []struct {
+		name     string
+		filename string
+		content  []byte
+		want     [][]string
+	}{
+		{
+			name:     "xlsx",
+			filename: "manufacturers.xlsx",
+			content:  xlsx,
+			want:     [][]string{{"Code", "Description"}, {"SHL", "Shell"}},
+		},
+		{
+			name:     "xlsx without extension",
+			filename: "upload",
+			content:  xlsx,
+			want:     [][]string{{"Code", "Description"}, {"SHL", "Shell"}},
+		},
+		{
+			name:     "xlsx template with hidden reference sheet",
+			filename: "request_template.xlsx",
+			content:  template,
+			want:     [][]string{{"Plant", "Long Text"}, {"1000", "BEARING"}},
+		},
+		{
+			name:     "csv with bom and quoted newline",
+			filename: "manufacturers.csv",
+			content:  []byte("\xef\xbb\xbfCode,Description\r\nSHL,\"Shell\r\nGlobal, Ltd\"\r\nPTM,Pertamina\r\n"),
+			want:     [][]string{{"Code", "Description"}, {"SHL", "Shell\nGlobal, Ltd"}, {"PTM", "Pertamina"}},
+		},
+		{
+			name:     "semicolon csv",
+			filename: "SE16N.csv",
+			content:  []byte("Code;Description\nSHL;Shell, Global\n"),
+			want:     [][]string{{"Code", "Description"}, {"SHL", "Shell, Global"}},
+		},
+		{
+			name:     "tab delimited text",
+			filename: "SQVI.txt",
+			content:  []byte("Code\tDescription\nSHL\tShell; Global\n"),
+			want:     [][]string{{"Code", "Description"}, {"SHL", "Shell; Global"}},
+		},
+		{
+			name:     "tsv",
+			filename: "manufacturers.tsv",
+			content:  []byte("Code\nSHL\n"),
+			want:     [][]string{{"Code"}, {"SHL"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.Open(bytes.NewReader(tt.content), tt.filename)
+			if err != nil {
+				t.Fatalf("Open() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Open() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	for _, filename := range []string{"manufacturers.xls", "manufacturers.xlsx"} {
+		if _, err := r.Open(strings.NewReader("Code,Description\n"), filename); !err.ContainsCodes(errors.ParsingFileFailure) {
+			t.Errorf("Open(%s) error = %v, want %s", filename, err, errors.ParsingFileFailure)
+		}
+	}
+}
+
+func TestReaderLimits(t *testing.T) {
+	config := new(configs.Config)
+	config.App.Spreadsheet.MaxFileSizeMB = 1
+	config.App.Spreadsheet.MaxRows = 2
+
+	r, err := NewReader(config)
+	if err != nil {
+		t.Fatalf("NewReader() error = %v", err)
+	}
+
+	if _, err := r.Open(strings.NewReader("Code\nA\nB\n"), "rows.csv"); !err.ContainsCodes(errors.TooManyRows) {
+		t.Errorf("Open() error = %v, want %s", err, errors.TooManyRows)
+	}
+
+	large := "Code\n" + strings.Repeat("A", 1<<20) + "\n"
+	if _, err := r.Open(strings.NewReader(large), "large.csv"); !err.ContainsCodes(errors.FileOversize) {
+		t.Errorf("Open() error = %v, want %s", err, errors.FileOversize)
+	}
+}
+
+func TestColumns(t *testing.T) {
+	known := []string{"Code", "Description", "Valuation Class"}
+
+	c, err := NewColumns([]string{"Description", "", " Code "}, known)
+	if err != nil {
+		t.Fatalf("NewColumns() error = %v", err)
+	}
+
+	row := []string{" Spare Parts ", "", "ERSA"}
+	if got := c.Value(row, "Code"); got != "ERSA" {
+		t.Errorf("Value(Code) = %s, want ERSA", got)
+	}
+	if got := c.Value(row, "Description"); got != "Spare Parts" {
+		t.Errorf("Value(Description) = %s, want Spare Parts", got)
+	}
+	if got := c.Optional(row, "Valuation Class"); got != nil {
+		t.Errorf("Optional(Valuation Class) = %s, want nil", *got)
+	}
+	if got := c.Value([]string{"Short"}, "Code"); got != "" {
+		t.Errorf("Value(Code) of short row = %s, want empty", got)
+	}
+
+	if _, err := NewColumns([]string{"Code", "Plant"}, known); !err.ContainsCodes(errors.UnknownField) {
+		t.Errorf("NewColumns() error = %v, want %s", err, errors.UnknownField)
+	}
+	if _, err := NewColumns([]string{"Code", "Code"}, known); !err.ContainsCodes(errors.DuplicateSpreadsheetColumn) {
+		t.Errorf("NewColumns() error = %v, want %s", err, errors.DuplicateSpreadsheetColumn)
+	}
+
+	if !IsBlank([]string{"", " ", "\t"}) || IsBlank([]string{"", "x"}) {
+		t.Errorf("IsBlank() returns unexpected result")
+	}
+}
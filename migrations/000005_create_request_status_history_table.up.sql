@@ -0,0 +1,20 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS request_status_history (
+    id          VARCHAR(255)  NOT NULL,
+    request_id  VARCHAR(255)  NOT NULL,
+    from_status TINYINT,
+    to_status   TINYINT       NOT NULL,
+    actor       VARCHAR(255)  NOT NULL,
+    reason      VARCHAR(1023),
+    created_at  INT UNSIGNED  DEFAULT (UNIX_TIMESTAMP()),
+
+    PRIMARY KEY (id),
+    FOREIGN KEY (request_id) REFERENCES requests (id)
+);
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
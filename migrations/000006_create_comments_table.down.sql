@@ -0,0 +1,11 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+DROP TABLE IF EXISTS comment_mentions;
+
+DROP TABLE IF EXISTS comments;
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
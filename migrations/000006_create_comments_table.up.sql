@@ -0,0 +1,32 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS comments (
+    id          VARCHAR(255)  NOT NULL,
+    request_id  VARCHAR(255)  NOT NULL,
+    material_id VARCHAR(255),
+    parent_id   VARCHAR(255),
+    content     VARCHAR(4095) NOT NULL,
+    created_by  VARCHAR(255)  NOT NULL,
+    created_at  INT UNSIGNED  DEFAULT (UNIX_TIMESTAMP()),
+    updated_at  INT UNSIGNED  DEFAULT (UNIX_TIMESTAMP()),
+    deleted_at  INT UNSIGNED  DEFAULT 0,
+
+    PRIMARY KEY (id),
+    FOREIGN KEY (request_id) REFERENCES requests (id)
+);
+
+CREATE INDEX comment_parent_idx ON comments (parent_id);
+
+CREATE TABLE IF NOT EXISTS comment_mentions (
+    comment_id VARCHAR(255) NOT NULL,
+    user_id    VARCHAR(255) NOT NULL,
+
+    PRIMARY KEY (comment_id, user_id),
+    FOREIGN KEY (comment_id) REFERENCES comments (id)
+);
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
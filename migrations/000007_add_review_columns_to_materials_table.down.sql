@@ -0,0 +1,14 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+ALTER TABLE materials
+    DROP COLUMN review_decision,
+    DROP COLUMN review_reason_code,
+    DROP COLUMN review_note,
+    DROP COLUMN reviewed_by,
+    DROP COLUMN reviewed_at;
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
@@ -0,0 +1,14 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+ALTER TABLE materials
+    ADD COLUMN review_decision    VARCHAR(255),
+    ADD COLUMN review_reason_code VARCHAR(255),
+    ADD COLUMN review_note        VARCHAR(1023),
+    ADD COLUMN reviewed_by        VARCHAR(255),
+    ADD COLUMN reviewed_at        INT UNSIGNED;
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
@@ -0,0 +1,12 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+DROP INDEX request_assignee_idx ON requests;
+
+ALTER TABLE requests
+    DROP COLUMN assigned_to;
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
@@ -0,0 +1,12 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+ALTER TABLE requests
+    ADD COLUMN assigned_to VARCHAR(255);
+
+CREATE INDEX request_assignee_idx ON requests (assigned_to);
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
@@ -0,0 +1,13 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+DROP INDEX request_due_idx ON requests;
+
+ALTER TABLE requests
+    DROP COLUMN due_at,
+    DROP COLUMN escalated_at;
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
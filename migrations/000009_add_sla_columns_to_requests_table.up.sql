@@ -0,0 +1,13 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+ALTER TABLE requests
+    ADD COLUMN due_at       INT UNSIGNED,
+    ADD COLUMN escalated_at INT UNSIGNED;
+
+CREATE INDEX request_due_idx ON requests (due_at);
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
@@ -0,0 +1,13 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+DROP INDEX material_number_idx ON materials;
+
+ALTER TABLE materials
+    DROP COLUMN part_number,
+    DROP COLUMN duplicate_of;
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
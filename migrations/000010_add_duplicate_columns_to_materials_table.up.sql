@@ -0,0 +1,13 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+ALTER TABLE materials
+    ADD COLUMN part_number  VARCHAR(255),
+    ADD COLUMN duplicate_of VARCHAR(255);
+
+CREATE INDEX material_number_idx ON materials (number);
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
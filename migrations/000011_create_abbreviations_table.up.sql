@@ -0,0 +1,17 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS abbreviations (
+    term         VARCHAR(255) NOT NULL,
+    abbreviation VARCHAR(255) NOT NULL,
+    created_at   INT UNSIGNED DEFAULT (UNIX_TIMESTAMP()),
+    updated_at   INT UNSIGNED DEFAULT (UNIX_TIMESTAMP()),
+    deleted_at   INT UNSIGNED DEFAULT 0,
+
+    PRIMARY KEY (term, deleted_at)
+);
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
@@ -0,0 +1,10 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+ALTER TABLE materials
+    DROP COLUMN revision_of;
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
@@ -0,0 +1,10 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+ALTER TABLE materials
+    ADD COLUMN revision_of VARCHAR(255);
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
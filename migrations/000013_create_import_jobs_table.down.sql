@@ -0,0 +1,9 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+DROP TABLE IF EXISTS import_jobs;
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
@@ -0,0 +1,25 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS import_jobs (
+    id             VARCHAR(255) NOT NULL,
+    entity         VARCHAR(255) NOT NULL,
+    mode           VARCHAR(255) NOT NULL,
+    dry_run        TINYINT(1)   NOT NULL,
+    status         VARCHAR(255) NOT NULL,
+    filename       VARCHAR(255) NOT NULL,
+    file           LONGBLOB,
+    processed_rows INT UNSIGNED DEFAULT 0,
+    report         JSON,
+    error_code     VARCHAR(255),
+    created_by     VARCHAR(255) NOT NULL,
+    created_at     INT UNSIGNED DEFAULT (UNIX_TIMESTAMP()),
+    updated_at     INT UNSIGNED DEFAULT (UNIX_TIMESTAMP()),
+
+    PRIMARY KEY (id)
+);
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
@@ -0,0 +1,11 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+DROP TABLE IF EXISTS material_type_groups;
+
+DROP TABLE IF EXISTS plant_material_types;
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
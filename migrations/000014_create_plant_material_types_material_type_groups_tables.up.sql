@@ -0,0 +1,23 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS plant_material_types (
+    plant_code  VARCHAR(255) NOT NULL,
+    type_code   VARCHAR(255) NOT NULL,
+    created_at  INT UNSIGNED DEFAULT (UNIX_TIMESTAMP()),
+
+    PRIMARY KEY (plant_code, type_code)
+);
+
+CREATE TABLE IF NOT EXISTS material_type_groups (
+    type_code   VARCHAR(255) NOT NULL,
+    group_code  VARCHAR(255) NOT NULL,
+    created_at  INT UNSIGNED DEFAULT (UNIX_TIMESTAMP()),
+
+    PRIMARY KEY (type_code, group_code)
+);
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
@@ -0,0 +1,9 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+DROP INDEX material_duplicate_idx ON materials;
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
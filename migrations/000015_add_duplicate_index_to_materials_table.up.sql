@@ -0,0 +1,9 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+CREATE INDEX material_duplicate_idx ON materials (plant_code, type_code, short_text);
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
@@ -0,0 +1,10 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+ALTER TABLE import_jobs DROP COLUMN file;
+ALTER TABLE import_jobs ADD COLUMN file_path VARCHAR(255) AFTER filename;
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
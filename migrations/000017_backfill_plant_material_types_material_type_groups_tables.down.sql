@@ -0,0 +1,11 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+DELETE FROM plant_material_types;
+
+DELETE FROM material_type_groups;
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file
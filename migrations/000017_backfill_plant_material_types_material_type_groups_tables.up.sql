@@ -0,0 +1,19 @@
+SET autocommit = OFF;
+
+BEGIN;
+
+INSERT IGNORE INTO plant_material_types (plant_code, type_code)
+    SELECT p.code, mt.code
+    FROM plants p
+    CROSS JOIN material_types mt
+    WHERE p.deleted_at = 0 AND mt.deleted_at = 0;
+
+INSERT IGNORE INTO material_type_groups (type_code, group_code)
+    SELECT mt.code, mg.code
+    FROM material_types mt
+    CROSS JOIN material_groups mg
+    WHERE mt.deleted_at = 0 AND mg.deleted_at = 0;
+
+COMMIT;
+
+SET autocommit = ON;
\ No newline at end of file